@@ -0,0 +1,118 @@
+package domainavailability
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Availability is the registration state of the domain name.
+// The value returned by the API is preserved as is, including values other than AVAILABLE and UNAVAILABLE,
+// e.g. RESERVED or PREMIUM, so new server states do not break parsing. States are compared case-insensitively.
+type Availability string
+
+const (
+	// Unknown means the API didn't return the registration state.
+	Unknown Availability = ""
+
+	// Available means the domain name can be registered.
+	Available Availability = "AVAILABLE"
+
+	// Unavailable means the domain name is registered.
+	Unavailable Availability = "UNAVAILABLE"
+)
+
+// unknownName is the text representation of Unknown.
+const unknownName = "UNKNOWN"
+
+// ParseAvailability returns Availability of the API value keeping it as is. Empty and UNKNOWN values in any case
+// are Unknown.
+func ParseAvailability(s string) Availability {
+	if a := Availability(s); a.IsUnknown() {
+		return Unknown
+	}
+
+	return Availability(s)
+}
+
+// IsAvailable reports whether the domain name can be registered.
+func (a Availability) IsAvailable() bool {
+	return a.is(string(Available))
+}
+
+// IsUnavailable reports whether the domain name is registered.
+func (a Availability) IsUnavailable() bool {
+	return a.is(string(Unavailable))
+}
+
+// IsUnknown reports whether the registration state wasn't returned.
+func (a Availability) IsUnknown() bool {
+	return a.is(string(Unknown)) || a.is(unknownName)
+}
+
+// IsOther reports whether the API returned a state other than AVAILABLE and UNAVAILABLE.
+func (a Availability) IsOther() bool {
+	return !a.IsUnknown() && !a.IsAvailable() && !a.IsUnavailable()
+}
+
+// Equal reports whether both values represent the same state regardless of case.
+func (a Availability) Equal(b Availability) bool {
+	if a.IsUnknown() || b.IsUnknown() {
+		return a.IsUnknown() && b.IsUnknown()
+	}
+
+	return b.is(string(a))
+}
+
+// is reports whether the state is the name ignoring case and surrounding spaces.
+func (a Availability) is(name string) bool {
+	return strings.EqualFold(strings.TrimSpace(string(a)), strings.TrimSpace(name))
+}
+
+// String returns the API representation of the state, UNKNOWN for Unknown.
+func (a Availability) String() string {
+	if a.IsUnknown() {
+		return unknownName
+	}
+
+	return string(a)
+}
+
+// MarshalText encodes the state as its API representation, UNKNOWN for Unknown.
+func (a Availability) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText decodes the state from its API representation.
+func (a *Availability) UnmarshalText(text []byte) error {
+	*a = ParseAvailability(string(text))
+
+	return nil
+}
+
+// MarshalJSON encodes the state as a JSON string, null for Unknown.
+func (a Availability) MarshalJSON() ([]byte, error) {
+	if a.IsUnknown() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(string(a))
+}
+
+// UnmarshalJSON decodes the state from a JSON string or null.
+func (a *Availability) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*a = Unknown
+
+		return nil
+	}
+
+	str, err := unmarshalString(data)
+	if err != nil {
+		return err
+	}
+
+	*a = ParseAvailability(str)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package domainavailability
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+)
+
+// TestAvailability tests JSON, text and XML encoding/parsing functions for the Availability values.
+func TestAvailability(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		want     Availability
+		wantJSON string
+		wantText string
+		check    func(a Availability) bool
+	}{
+		{
+			name:     "available",
+			json:     `"AVAILABLE"`,
+			want:     Available,
+			wantJSON: `"AVAILABLE"`,
+			wantText: "AVAILABLE",
+			check:    Availability.IsAvailable,
+		},
+		{
+			name:     "unavailable lower case",
+			json:     `"unavailable"`,
+			want:     Availability("unavailable"),
+			wantJSON: `"unavailable"`,
+			wantText: "unavailable",
+			check:    Availability.IsUnavailable,
+		},
+		{
+			name:     "null",
+			json:     `null`,
+			want:     Unknown,
+			wantJSON: `null`,
+			wantText: "UNKNOWN",
+			check:    Availability.IsUnknown,
+		},
+		{
+			name:     "unknown lower case",
+			json:     `"unknown"`,
+			want:     Unknown,
+			wantJSON: `null`,
+			wantText: "UNKNOWN",
+			check:    Availability.IsUnknown,
+		},
+		{
+			name:     "empty",
+			json:     `""`,
+			want:     Unknown,
+			wantJSON: `null`,
+			wantText: "UNKNOWN",
+			check:    Availability.IsUnknown,
+		},
+		{
+			name:     "unrecognized",
+			json:     `"RESERVED"`,
+			want:     Availability("RESERVED"),
+			wantJSON: `"RESERVED"`,
+			wantText: "RESERVED",
+			check:    Availability.IsOther,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Availability
+
+			err := json.Unmarshal([]byte(tt.json), &a)
+			checkErr(t, err, "")
+
+			if a != tt.want || !tt.check(a) {
+				t.Errorf("json.Unmarshal() = %q, want %q", a, tt.want)
+			}
+
+			bb, err := json.Marshal(a)
+			checkErr(t, err, "")
+
+			if string(bb) != tt.wantJSON {
+				t.Errorf("json.Marshal() = %s, want %s", bb, tt.wantJSON)
+			}
+
+			if a.String() != tt.wantText {
+				t.Errorf("String() = %s, want %s", a.String(), tt.wantText)
+			}
+
+			type doc struct {
+				Availability Availability `xml:"domainAvailability"`
+			}
+
+			bb, err = xml.Marshal(doc{a})
+			checkErr(t, err, "")
+
+			var got doc
+
+			err = xml.Unmarshal(bb, &got)
+			checkErr(t, err, "")
+
+			if got.Availability != a {
+				t.Errorf("xml round trip = %q, want %q", got.Availability, a)
+			}
+		})
+	}
+
+	if !Availability("unavailable").Equal(Unavailable) || Available.Equal(Unavailable) || !Unknown.Equal("unknown") {
+		t.Errorf("Equal() doesn't compare states case-insensitively")
+	}
+
+	if err := json.Unmarshal([]byte(`1`), new(Availability)); err == nil {
+		t.Errorf("json.Unmarshal() of a number error = nil, want error")
+	}
+}
@@ -0,0 +1,218 @@
+package domainavailability
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling the API while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// Default circuit breaker parameters.
+const (
+	defaultFailureThreshold = 5
+	defaultCooldown         = 30 * time.Second
+)
+
+// BreakerState is the state of the circuit breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed lets all requests through.
+	BreakerClosed BreakerState = iota
+
+	// BreakerOpen fails all requests fast with ErrCircuitOpen.
+	BreakerOpen
+
+	// BreakerHalfOpen lets a single probe request through to check if the API has recovered.
+	BreakerHalfOpen
+)
+
+// String returns the state name.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+
+	return "unknown"
+}
+
+// BreakerParams is used to create CircuitBreaker. None of parameters are mandatory.
+type BreakerParams struct {
+	// FailureThreshold is the number of consecutive failures which opens the circuit
+	// If it's zero then 5 is used
+	FailureThreshold int
+
+	// Cooldown is the time the circuit stays open before a probe request is let through
+	// If it's zero then 30 seconds is used
+	Cooldown time.Duration
+
+	// OnStateChange is called on every state change
+	// It's called synchronously without holding the breaker lock, so it may call State but must not block
+	OnStateChange func(from, to BreakerState)
+}
+
+// CircuitBreaker stops calling the API after consecutive failures and retries after the cooldown.
+// Transport errors and 5xx status codes are failures.
+type CircuitBreaker struct {
+	params BreakerParams
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+
+	// generation is incremented on every state change, so results of requests
+	// allowed in an earlier state are ignored
+	generation uint64
+
+	// changes are the state changes to report after unlocking b.mu
+	changes []stateChange
+
+	// now is replaceable for testing
+	now func() time.Time
+}
+
+// stateChange is a state change waiting for OnStateChange.
+type stateChange struct {
+	from, to BreakerState
+}
+
+// NewCircuitBreaker creates CircuitBreaker in the closed state.
+func NewCircuitBreaker(params BreakerParams) *CircuitBreaker {
+	if params.FailureThreshold <= 0 {
+		params.FailureThreshold = defaultFailureThreshold
+	}
+
+	if params.Cooldown <= 0 {
+		params.Cooldown = defaultCooldown
+	}
+
+	return &CircuitBreaker{
+		params: params,
+		now:    time.Now,
+	}
+}
+
+// State returns the current state.
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.params.Cooldown {
+		return BreakerHalfOpen
+	}
+
+	return b.state
+}
+
+// allow reports whether the request may be sent. It returns ErrCircuitOpen if it may not.
+// The returned generation must be passed to record or release with the result of the request.
+func (b *CircuitBreaker) allow() (generation uint64, err error) {
+	b.mu.Lock()
+	defer b.unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.params.Cooldown {
+			return 0, ErrCircuitOpen
+		}
+
+		b.setState(BreakerHalfOpen)
+		b.probing = true
+	case BreakerHalfOpen:
+		if b.probing {
+			return 0, ErrCircuitOpen
+		}
+
+		b.probing = true
+	}
+
+	return b.generation, nil
+}
+
+// record updates the state with the result of the request allowed in the generation.
+// Results of requests allowed before the last state change are ignored.
+func (b *CircuitBreaker) record(generation uint64, failed bool) {
+	b.mu.Lock()
+	defer b.unlock()
+
+	if generation != b.generation {
+		return
+	}
+
+	if b.state == BreakerHalfOpen {
+		b.probing = false
+
+		if failed {
+			b.open()
+		} else {
+			b.failures = 0
+			b.setState(BreakerClosed)
+		}
+
+		return
+	}
+
+	if !failed {
+		b.failures = 0
+
+		return
+	}
+
+	b.failures++
+	if b.state == BreakerClosed && b.failures >= b.params.FailureThreshold {
+		b.open()
+	}
+}
+
+// release gives back the probe slot of the request whose result is unknown, e.g. canceled by the caller.
+func (b *CircuitBreaker) release(generation uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if generation == b.generation {
+		b.probing = false
+	}
+}
+
+// unlock unlocks b.mu and then reports the state changes made while it was held.
+func (b *CircuitBreaker) unlock() {
+	changes := b.changes
+	b.changes = nil
+
+	b.mu.Unlock()
+
+	if b.params.OnStateChange == nil {
+		return
+	}
+
+	for _, change := range changes {
+		b.params.OnStateChange(change.from, change.to)
+	}
+}
+
+// open switches to the open state. It must be called with b.mu held.
+func (b *CircuitBreaker) open() {
+	b.openedAt = b.now()
+	b.setState(BreakerOpen)
+}
+
+// setState switches the state and starts a new generation. It must be called with b.mu held.
+// The change is reported by unlock.
+func (b *CircuitBreaker) setState(state BreakerState) {
+	if b.state == state {
+		return
+	}
+
+	b.changes = append(b.changes, stateChange{from: b.state, to: state})
+	b.state = state
+	b.generation++
+}
@@ -0,0 +1,172 @@
+package domainavailability
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestCircuitBreaker tests the circuit breaker state transitions.
+func TestCircuitBreaker(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"UNAVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	server := dummyServer(resp, `<?xml version="1.0" encoding="utf-8"?><>`, "")
+	defer server.Close()
+
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var transitions []string
+
+	breaker := NewCircuitBreaker(BreakerParams{
+		FailureThreshold: 2,
+		Cooldown:         time.Minute,
+		OnStateChange: func(from, to BreakerState) {
+			transitions = append(transitions, from.String()+"->"+to.String())
+		},
+	})
+	breaker.now = func() time.Time { return now }
+
+	newBreakerAPI := func(path string) *Client {
+		apiURL, err := url.Parse(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		apiURL.Path = path
+
+		return NewClient(apiKey, ClientParams{
+			HTTPClient:                server.Client(),
+			DomainAvailabilityBaseURL: apiURL,
+			CircuitBreaker:            breaker,
+		})
+	}
+
+	failing := newBreakerAPI(pathDomainAvailabilityResponse500)
+	healthy := newBreakerAPI(pathDomainAvailabilityResponseOK)
+
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := failing.GetRaw(ctx, "whoisxmlapi.com"); errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("GetRaw() call %d error = %v, want API error", i, err)
+		}
+	}
+
+	if state := breaker.State(); state != BreakerOpen {
+		t.Fatalf("State() = %v, want %v", state, BreakerOpen)
+	}
+
+	if _, _, err := healthy.Get(ctx, "whoisxmlapi.com"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Get() error = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	now = now.Add(time.Minute)
+
+	if state := breaker.State(); state != BreakerHalfOpen {
+		t.Fatalf("State() = %v, want %v", state, BreakerHalfOpen)
+	}
+
+	if _, _, err := healthy.Get(ctx, "whoisxmlapi.com"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if state := breaker.State(); state != BreakerClosed {
+		t.Fatalf("State() = %v, want %v", state, BreakerClosed)
+	}
+
+	want := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if len(transitions) != len(want) {
+		t.Fatalf("transitions = %v, want %v", transitions, want)
+	}
+
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Errorf("transitions = %v, want %v", transitions, want)
+		}
+	}
+}
+
+// TestCircuitBreakerStaleResults tests results of requests allowed before a state change are ignored.
+func TestCircuitBreakerStaleResults(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	breaker := NewCircuitBreaker(BreakerParams{FailureThreshold: 1, Cooldown: time.Minute})
+	breaker.now = func() time.Time { return now }
+
+	slow, err := breaker.allow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failed, err := breaker.allow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	breaker.record(failed, true)
+
+	now = now.Add(time.Minute)
+
+	probe, err := breaker.allow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The slow request was allowed while closed, so its result says nothing about the recovery.
+	breaker.record(slow, false)
+	breaker.release(slow)
+
+	if state := breaker.State(); state != BreakerHalfOpen {
+		t.Fatalf("State() after a stale result = %v, want %v", state, BreakerHalfOpen)
+	}
+
+	if _, err := breaker.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("allow() during the probe error = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	breaker.record(probe, false)
+
+	if state := breaker.State(); state != BreakerClosed {
+		t.Fatalf("State() after the probe = %v, want %v", state, BreakerClosed)
+	}
+}
+
+// TestCircuitBreakerCallbackState tests OnStateChange may call State.
+func TestCircuitBreakerCallbackState(t *testing.T) {
+	var (
+		breaker *CircuitBreaker
+		states  []BreakerState
+	)
+
+	breaker = NewCircuitBreaker(BreakerParams{
+		FailureThreshold: 1,
+		OnStateChange: func(_, _ BreakerState) {
+			states = append(states, breaker.State())
+		},
+	})
+
+	generation, err := breaker.allow()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		breaker.record(generation, true)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("record() deadlocked calling OnStateChange")
+	}
+
+	if len(states) != 1 || states[0] != BreakerOpen {
+		t.Errorf("State() in OnStateChange = %v, want [%v]", states, BreakerOpen)
+	}
+}
@@ -0,0 +1,226 @@
+package domainavailability
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+// BudgetError is returned without calling the API when the call would exceed the hard limit.
+type BudgetError struct {
+	// Credits is the type of credits: DA|WHOIS.
+	Credits string
+
+	// Used is the number of credits already consumed.
+	Used int64
+
+	// Limit is the hard limit.
+	Limit int64
+}
+
+// Error returns error message as a string.
+func (e *BudgetError) Error() string {
+	return "credit budget exhausted: " + e.Credits + " credits used " +
+		strconv.FormatInt(e.Used, 10) + " of " + strconv.FormatInt(e.Limit, 10)
+}
+
+// DefaultCost returns the number of credits of a call, which is one regardless of the mode and credits type.
+func DefaultCost(mode, credits string) int64 {
+	return 1
+}
+
+// BudgetParams is used to create Budget. None of parameters are mandatory.
+type BudgetParams struct {
+	// HardLimits maps credit types (DA|WHOIS) to the number of credits after which calls are refused
+	// Credit types without a limit are not limited
+	HardLimits map[string]int64
+
+	// SoftLimits maps credit types to the number of credits after which OnSoftLimit is called
+	SoftLimits map[string]int64
+
+	// OnSoftLimit is called once per credit type when its soft limit is reached
+	OnSoftLimit func(credits string, used int64)
+
+	// Cost returns the number of credits of a call with the mode and the credits type
+	// If it's nil then DefaultCost is used
+	Cost func(mode, credits string) int64
+}
+
+// Budget tracks credits consumed per credit type and enforces the limits.
+// Credits are reserved before the API request and given back if the request fails.
+type Budget struct {
+	params BudgetParams
+
+	mu       sync.Mutex
+	used     map[string]int64
+	notified map[string]bool
+}
+
+// NewBudget creates Budget with nothing consumed.
+func NewBudget(params BudgetParams) *Budget {
+	if params.Cost == nil {
+		params.Cost = DefaultCost
+	}
+
+	return &Budget{
+		params:   params,
+		used:     make(map[string]int64),
+		notified: make(map[string]bool),
+	}
+}
+
+// Used returns the number of consumed credits of the type.
+func (b *Budget) Used(credits string) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.used[credits]
+}
+
+// Remaining returns the number of credits of the type left before the hard limit.
+// The second value is false if the type is not limited.
+func (b *Budget) Remaining(credits string) (int64, bool) {
+	limit, ok := b.params.HardLimits[credits]
+	if !ok {
+		return 0, false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if remaining := limit - b.used[credits]; remaining > 0 {
+		return remaining, true
+	}
+
+	return 0, true
+}
+
+// reserve takes the credits of the type or returns BudgetError if the hard limit would be exceeded.
+func (b *Budget) reserve(credits string, amount int64) error {
+	b.mu.Lock()
+
+	used := b.used[credits]
+	if limit, ok := b.params.HardLimits[credits]; ok && used+amount > limit {
+		b.mu.Unlock()
+
+		return &BudgetError{Credits: credits, Used: used, Limit: limit}
+	}
+
+	used += amount
+	b.used[credits] = used
+
+	var notify bool
+	if limit, ok := b.params.SoftLimits[credits]; ok && used >= limit && !b.notified[credits] {
+		b.notified[credits] = true
+		notify = b.params.OnSoftLimit != nil
+	}
+
+	b.mu.Unlock()
+
+	if notify {
+		b.params.OnSoftLimit(credits, used)
+	}
+
+	return nil
+}
+
+// refund gives back the credits of the failed request.
+func (b *Budget) refund(credits string, amount int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.used[credits] -= amount
+}
+
+// Cost is the estimated cost of a set of calls.
+type Cost struct {
+	// Mode is the check mode of the calls.
+	Mode string
+
+	// Credits is the type of credits consumed.
+	Credits string
+
+	// Amount is the number of credits.
+	Amount int64
+}
+
+// EstimateCost returns the cost of checking domainCount domain names with the options,
+// using the cost function of the client budget or DefaultCost if there's no budget.
+func (c *Client) EstimateCost(domainCount int, opts ...Option) Cost {
+	mode, credits := modeAndCredits(c.EffectiveQuery("", opts...).Get)
+
+	cost := DefaultCost
+	if c.budget != nil {
+		cost = c.budget.params.Cost
+	}
+
+	return Cost{
+		Mode:    mode,
+		Credits: credits,
+		Amount:  int64(domainCount) * cost(mode, credits),
+	}
+}
+
+// budgetKey is the context key of the budget.
+type budgetKey struct{}
+
+// ContextWithBudget returns the context whose calls are charged to the budget in addition to the client budget.
+// When the client coalesces requests, the shared request is charged to the budget of the call which started it,
+// calls joining it are not charged.
+func ContextWithBudget(ctx context.Context, budget *Budget) context.Context {
+	return context.WithValue(ctx, budgetKey{}, budget)
+}
+
+// charge reserves the call cost in the client and the context budgets.
+// It returns the function giving the credits back.
+func (c *Client) charge(ctx context.Context, query func(string) string) (refund func(), err error) {
+	budgets := make([]*Budget, 0, 2)
+	if c.budget != nil {
+		budgets = append(budgets, c.budget)
+	}
+
+	if b, ok := ctx.Value(budgetKey{}).(*Budget); ok && b != nil && b != c.budget {
+		budgets = append(budgets, b)
+	}
+
+	if len(budgets) == 0 {
+		return func() {}, nil
+	}
+
+	mode, credits := modeAndCredits(query)
+
+	amounts := make([]int64, 0, len(budgets))
+
+	refund = func() {
+		for i, amount := range amounts {
+			budgets[i].refund(credits, amount)
+		}
+	}
+
+	for _, b := range budgets {
+		amount := b.params.Cost(mode, credits)
+		if err = b.reserve(credits, amount); err != nil {
+			refund()
+
+			return nil, err
+		}
+
+		amounts = append(amounts, amount)
+	}
+
+	return refund, nil
+}
+
+// modeAndCredits returns the mode and the credits type of the query, the API defaults if they are absent.
+func modeAndCredits(query func(string) string) (mode, credits string) {
+	mode, credits = query("mode"), query("credits")
+	if mode == "" {
+		mode = DefaultMode
+	}
+
+	if credits == "" {
+		credits = DefaultCredits
+	}
+
+	return mode, credits
+}
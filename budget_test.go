@@ -0,0 +1,84 @@
+package domainavailability
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestBudget tests charging and enforcing the limits of the client and context budgets.
+func TestBudget(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"UNAVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	server := dummyServer(resp, `<?xml version="1.0" encoding="utf-8"?><>`, "")
+	defer server.Close()
+
+	var softLimitReached string
+
+	budget := NewBudget(BudgetParams{
+		HardLimits: map[string]int64{"DA": 3},
+		SoftLimits: map[string]int64{"DA": 2},
+		OnSoftLimit: func(credits string, used int64) {
+			softLimitReached = credits
+		},
+		Cost: func(mode, credits string) int64 {
+			if mode == "DNS_AND_WHOIS" {
+				return 2
+			}
+
+			return 1
+		},
+	})
+
+	api := newAPI(server, pathDomainAvailabilityResponseOK)
+	api.budget = budget
+
+	ctx := context.Background()
+
+	if cost := api.EstimateCost(10, OptionCredits("DA"), OptionMode("DNS_AND_WHOIS")); cost.Amount != 20 ||
+		cost.Credits != "DA" || cost.Mode != "DNS_AND_WHOIS" {
+		t.Errorf("EstimateCost() = %+v, want 20 DA credits", cost)
+	}
+
+	if _, _, err := api.Get(ctx, "whoisxmlapi.com", OptionCredits("DA"), OptionMode("DNS_AND_WHOIS")); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if softLimitReached != "DA" {
+		t.Errorf("OnSoftLimit() was not called")
+	}
+
+	_, _, err := api.Get(ctx, "whoisxmlapi.com", OptionCredits("DA"), OptionMode("DNS_AND_WHOIS"))
+
+	var budgetErr *BudgetError
+	if !errors.As(err, &budgetErr) || budgetErr.Used != 2 || budgetErr.Limit != 3 {
+		t.Fatalf("Get() error = %v, want budget error", err)
+	}
+
+	if _, _, err = api.Get(ctx, "whoisxmlapi.com"); err != nil {
+		t.Errorf("Get() with WHOIS credits error = %v", err)
+	}
+
+	if used := budget.Used("WHOIS"); used != 1 {
+		t.Errorf("Used(WHOIS) = %d, want 1", used)
+	}
+
+	ctxBudget := NewBudget(BudgetParams{HardLimits: map[string]int64{"WHOIS": 0}})
+
+	if _, _, err = api.Get(ContextWithBudget(ctx, ctxBudget), "whoisxmlapi.com"); !errors.As(err, &budgetErr) {
+		t.Errorf("Get() with context budget error = %v, want budget error", err)
+	}
+
+	if used := budget.Used("WHOIS"); used != 1 {
+		t.Errorf("Used(WHOIS) = %d, want 1 as the refused call is given back", used)
+	}
+
+	failing := newAPI(server, pathDomainAvailabilityResponse500)
+	failing.budget = budget
+
+	_, _ = failing.GetRaw(ctx, "whoisxmlapi.com")
+
+	if used := budget.Used("WHOIS"); used != 1 {
+		t.Errorf("Used(WHOIS) = %d, want 1 as the failed call is given back", used)
+	}
+}
@@ -0,0 +1,78 @@
+package domainavailability
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultBulkWorkers is the number of concurrent requests used by GetBulk when workers is not positive.
+const defaultBulkWorkers = 8
+
+// BulkResult is the result of checking a single domain name of the bulk.
+type BulkResult struct {
+	// DomainName is the requested domain name.
+	DomainName string
+
+	// DomainAvailabilityResponse is the parsed API response, nil if the check failed.
+	*DomainAvailabilityResponse
+
+	// Err is the error of the check.
+	Err error
+}
+
+// GetBulk checks the domain names concurrently via service.Get using at most workers simultaneous requests.
+// Results are returned in the order of domainNames. Domain names not checked before the context
+// is canceled get the context error.
+func GetBulk(
+	ctx context.Context,
+	service DomainAvailabilityService,
+	domainNames []string,
+	workers int,
+	opts ...Option,
+) []BulkResult {
+	if workers <= 0 {
+		workers = defaultBulkWorkers
+	}
+
+	results := make([]BulkResult, len(domainNames))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers && w < len(domainNames); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				domainAvailabilityResp, _, err := service.Get(ctx, domainNames[i], opts...)
+				results[i] = BulkResult{
+					DomainName:                 domainNames[i],
+					DomainAvailabilityResponse: domainAvailabilityResp,
+					Err:                        err,
+				}
+			}
+		}()
+	}
+
+	next := 0
+
+loop:
+	for ; next < len(domainNames); next++ {
+		select {
+		case indexes <- next:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	for i := next; i < len(domainNames); i++ {
+		results[i] = BulkResult{DomainName: domainNames[i], Err: ctx.Err()}
+	}
+
+	return results
+}
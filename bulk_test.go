@@ -0,0 +1,48 @@
+package domainavailability
+
+import (
+	"context"
+	"testing"
+)
+
+// TestGetBulk tests the GetBulk function.
+func TestGetBulk(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"UNAVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	server := dummyServer(resp, "", "")
+	defer server.Close()
+
+	api := newAPI(server, pathDomainAvailabilityResponseOK)
+
+	domainNames := []string{"whoisxmlapi.com", "", "example.com", "example.org"}
+
+	results := GetBulk(context.Background(), api, domainNames, 2)
+	if len(results) != len(domainNames) {
+		t.Fatalf("GetBulk() returned %d results, want %d", len(results), len(domainNames))
+	}
+
+	for i, res := range results {
+		if res.DomainName != domainNames[i] {
+			t.Errorf("GetBulk()[%d].DomainName = %v, want %v", i, res.DomainName, domainNames[i])
+		}
+
+		if domainNames[i] == "" {
+			checkErr(t, res.Err, `invalid argument: "domainName" can not be empty`)
+
+			continue
+		}
+
+		if res.Err != nil || res.DomainAvailabilityResponse == nil {
+			t.Errorf("GetBulk()[%d] = %+v, want response", i, res)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, res := range GetBulk(ctx, api, domainNames, 1) {
+		if res.Err == nil {
+			t.Errorf("GetBulk() with canceled context got %+v, want error", res)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 const (
@@ -22,11 +23,58 @@ const defaultDomainAvailabilityURL = `https://domain-availability.whoisxmlapi.co
 // leaving this struct empty works just fine for most cases.
 type ClientParams struct {
 	// HTTPClient is the client used to access API endpoint
-	// If it's nil then value API client uses http.DefaultClient
+	// If it's nil then the client built from Timeout and Transport is used
 	HTTPClient *http.Client
 
+	// Timeout is the overall request timeout of the default HTTP client
+	// If it's zero then 30 seconds is used, negative value disables the timeout
+	Timeout time.Duration
+
+	// Transport is used to build the transport of the default HTTP client
+	Transport TransportParams
+
 	// DomainAvailabilityBaseURL is the endpoint for 'Domain Availability API' service
 	DomainAvailabilityBaseURL *url.URL
+
+	// CoalesceRequests enables sharing one in-flight API request between concurrent calls
+	// with the same domain name and options
+	CoalesceRequests bool
+
+	// CircuitBreaker fails requests fast while the API is failing
+	// If it's nil then requests are always sent
+	CircuitBreaker *CircuitBreaker
+
+	// Budget tracks and limits the consumed credits
+	// If it's nil then credits are not tracked
+	Budget *Budget
+
+	// Hedger sends a second request when the first one is slow
+	// If it's nil then requests are not hedged
+	Hedger *Hedger
+
+	// MaxResponseSize is the maximum size of the response body in bytes
+	// If it's zero then 10 MiB is used, negative value disables the limit
+	MaxResponseSize int64
+
+	// StreamResponses makes Get decode the response body while reading it instead of buffering it first
+	// The Body of the Response returned by Get is nil in this case
+	StreamResponses bool
+
+	// SchemaMode defines how Get treats the response fields unknown to the library
+	// If it's zero then unknown fields are dropped
+	SchemaMode SchemaMode
+
+	// OnUnknownFields is called by Get with the names of unknown response fields
+	// It's only called in the lenient and strict schema modes, so SchemaIgnore disables the check
+	OnUnknownFields func(domainName string, fields []string)
+
+	// ValidateResponses makes Get check that the response has the required fields
+	// and is for the requested domain name, see ValidateResponse
+	ValidateResponses bool
+
+	// DefaultOptions are applied to every Get and GetRaw call before the options of the call,
+	// so the options of the call override them
+	DefaultOptions []Option
 }
 
 // NewBasicClient creates Client with recommended parameters.
@@ -36,30 +84,61 @@ func NewBasicClient(apiKey string) *Client {
 
 // NewClient creates Client with specified parameters.
 func NewClient(apiKey string, params ClientParams) *Client {
+	client, err := newClient(apiKey, params)
+	if err != nil {
+		panic(err)
+	}
+
+	return client
+}
+
+// newClient creates Client with specified parameters without validating them.
+func newClient(apiKey string, params ClientParams) (*Client, error) {
 	var err error
 
 	apiBaseURL := params.DomainAvailabilityBaseURL
 	if apiBaseURL == nil {
 		apiBaseURL, err = url.Parse(defaultDomainAvailabilityURL)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
 	}
 
-	httpClient := http.DefaultClient
-	if params.HTTPClient != nil {
-		httpClient = params.HTTPClient
+	httpClient := params.HTTPClient
+	if httpClient == nil {
+		httpClient = NewHTTPClient(params.Timeout, params.Transport)
+	}
+
+	if params.MaxResponseSize == 0 {
+		params.MaxResponseSize = defaultMaxResponseSize
 	}
 
 	client := &Client{
 		client:    httpClient,
 		userAgent: userAgent,
 		apiKey:    apiKey,
+		breaker:   params.CircuitBreaker,
+		budget:    params.Budget,
+		hedger:    params.Hedger,
+
+		maxResponseSize: params.MaxResponseSize,
+		stream:          params.StreamResponses,
+
+		schemaMode:      params.SchemaMode,
+		onUnknownFields: params.OnUnknownFields,
+		validate:        params.ValidateResponses,
+
+		defaultOpts: append([]Option(nil), params.DefaultOptions...),
+	}
+
+	service := &domainAvailabilityServiceOp{client: client, baseURL: apiBaseURL}
+	if params.CoalesceRequests {
+		service.flights = newFlightGroup()
 	}
 
-	client.DomainAvailabilityService = &domainAvailabilityServiceOp{client: client, baseURL: apiBaseURL}
+	client.DomainAvailabilityService = service
 
-	return client
+	return client, nil
 }
 
 // Client is the client for Domain Availability API services.
@@ -69,10 +148,56 @@ type Client struct {
 	userAgent string
 	apiKey    string
 
+	breaker *CircuitBreaker
+	budget  *Budget
+	hedger  *Hedger
+
+	// maxResponseSize limits the response body size, negative if unlimited
+	maxResponseSize int64
+
+	// stream enables decoding Get responses while reading them
+	stream bool
+
+	// schemaMode defines how unknown response fields are treated
+	schemaMode SchemaMode
+
+	// onUnknownFields reports unknown response fields
+	onUnknownFields func(domainName string, fields []string)
+
+	// validate enables the sanity checks of Get responses
+	validate bool
+
+	// defaultOpts are applied before the options of every call
+	defaultOpts []Option
+
 	// DomainAvailability is an interface for Domain Availability API
 	DomainAvailabilityService
 }
 
+// DefaultOptions returns the options applied to every Get and GetRaw call.
+func (c *Client) DefaultOptions() []Option {
+	return append([]Option(nil), c.defaultOpts...)
+}
+
+// EffectiveQuery returns the query parameters GetRaw would send for the domain name and the options,
+// without the API key. The parameters are applied in the following order, later ones override earlier ones:
+// the domain name, the client default options, the options of the call.
+// Get additionally sets outputFormat to JSON after all options.
+func (c *Client) EffectiveQuery(domainName string, opts ...Option) url.Values {
+	q := url.Values{}
+	q.Set("domainName", domainName)
+
+	for _, opt := range c.defaultOpts {
+		opt(q)
+	}
+
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	return q
+}
+
 // NewRequest creates a basic API request.
 func (c *Client) NewRequest(method string, u *url.URL, body io.Reader) (*http.Request, error) {
 	var err error
@@ -91,11 +216,38 @@ func (c *Client) NewRequest(method string, u *url.URL, body io.Reader) (*http.Re
 	return req, nil
 }
 
-// Do sends the API request and returns the API response.
+// Do sends the API request and writes the response body to v.
+// The body larger than ClientParams.MaxResponseSize fails with ResponseTooLargeError.
 func (c *Client) Do(ctx context.Context, req *http.Request, v io.Writer) (response *http.Response, err error) {
+	return c.do(ctx, req, func(body io.Reader) error {
+		_, err := io.Copy(v, body)
+		return err
+	})
+}
+
+// do sends the API request and passes the size limited response body to consume.
+// The rest of the body is discarded, so the connection can be reused.
+func (c *Client) do(ctx context.Context, req *http.Request, consume func(body io.Reader) error) (response *http.Response, err error) {
 	req = req.WithContext(ctx)
 
+	var generation uint64
+
+	if c.breaker != nil {
+		if generation, err = c.breaker.allow(); err != nil {
+			return nil, err
+		}
+	}
+
 	resp, err := c.client.Do(req)
+
+	if c.breaker != nil {
+		if err != nil && ctx.Err() != nil {
+			c.breaker.release(generation)
+		} else {
+			c.breaker.record(generation, err != nil || resp.StatusCode >= 500)
+		}
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("cannot execute request: %w", err)
 	}
@@ -106,12 +258,21 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v io.Writer) (respon
 		}
 	}()
 
-	_, err = io.Copy(v, resp.Body)
+	if c.maxResponseSize >= 0 && resp.ContentLength > c.maxResponseSize {
+		return resp, fmt.Errorf("cannot read response: %w", &ResponseTooLargeError{Limit: c.maxResponseSize})
+	}
+
+	body := newLimitedReader(resp.Body, c.maxResponseSize)
+
+	if err = consume(body); err == nil {
+		_, err = io.Copy(io.Discard, body)
+	}
+
 	if err != nil {
 		return resp, fmt.Errorf("cannot read response: %w", err)
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // ErrorResponse is returned when the response status code is not 2xx.
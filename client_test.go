@@ -356,3 +356,40 @@ func TestDomainAvailabilityGetRaw(t *testing.T) {
 		})
 	}
 }
+
+// TestDefaultOptions tests that the client default options are applied before the options of the call.
+func TestDefaultOptions(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"UNAVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	var query url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query = req.URL.Query()
+		_, _ = w.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	client, err := New(apiKey,
+		WithHTTPClient(server.Client()),
+		WithBaseURL(server.URL),
+		WithDefaultOptions(OptionMode("DNS_AND_WHOIS"), OptionCredits("DA")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "credits=WHOIS&domainName=whoisxmlapi.com&mode=DNS_AND_WHOIS"
+	if got := client.EffectiveQuery("whoisxmlapi.com", OptionCredits("WHOIS")).Encode(); got != want {
+		t.Errorf("EffectiveQuery() = %v, want %v", got, want)
+	}
+
+	if _, _, err = client.Get(context.Background(), "whoisxmlapi.com", OptionCredits("WHOIS")); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	query.Del("apiKey")
+
+	want = "credits=WHOIS&domainName=whoisxmlapi.com&mode=DNS_AND_WHOIS&outputFormat=JSON"
+	if got := query.Encode(); got != want {
+		t.Errorf("Get() sent query %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,214 @@
+package domainavailability
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ClientOption configures Client created by New.
+type ClientOption func(p *ClientParams) error
+
+// WithHTTPClient sets the HTTP client used to access the API endpoint.
+// It conflicts with WithTimeout and WithTransport.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(p *ClientParams) error {
+		if httpClient == nil {
+			return &ArgError{"httpClient", "can not be nil"}
+		}
+
+		p.HTTPClient = httpClient
+
+		return nil
+	}
+}
+
+// WithBaseURL sets the Domain Availability API endpoint. The URL must be absolute with http or https scheme.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(p *ClientParams) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return &ArgError{"baseURL", err.Error()}
+		}
+
+		p.DomainAvailabilityBaseURL = u
+
+		return nil
+	}
+}
+
+// WithTimeout sets the overall request timeout of the default HTTP client.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(p *ClientParams) error {
+		p.Timeout = timeout
+
+		return nil
+	}
+}
+
+// WithTransport sets the transport parameters of the default HTTP client.
+func WithTransport(params TransportParams) ClientOption {
+	return func(p *ClientParams) error {
+		p.Transport = params
+
+		return nil
+	}
+}
+
+// WithCoalescing enables sharing one in-flight API request between concurrent identical calls.
+func WithCoalescing() ClientOption {
+	return func(p *ClientParams) error {
+		p.CoalesceRequests = true
+
+		return nil
+	}
+}
+
+// WithCircuitBreaker sets the circuit breaker.
+func WithCircuitBreaker(breaker *CircuitBreaker) ClientOption {
+	return func(p *ClientParams) error {
+		p.CircuitBreaker = breaker
+
+		return nil
+	}
+}
+
+// WithBudget sets the budget tracking and limiting the consumed credits.
+func WithBudget(budget *Budget) ClientOption {
+	return func(p *ClientParams) error {
+		p.Budget = budget
+
+		return nil
+	}
+}
+
+// WithHedging sets the hedger sending a second request when the first one is slow.
+func WithHedging(hedger *Hedger) ClientOption {
+	return func(p *ClientParams) error {
+		p.Hedger = hedger
+
+		return nil
+	}
+}
+
+// WithMaxResponseSize sets the maximum size of the response body in bytes, negative value disables the limit.
+func WithMaxResponseSize(size int64) ClientOption {
+	return func(p *ClientParams) error {
+		p.MaxResponseSize = size
+
+		return nil
+	}
+}
+
+// WithStreaming makes Get decode the response body while reading it instead of buffering it first.
+func WithStreaming() ClientOption {
+	return func(p *ClientParams) error {
+		p.StreamResponses = true
+
+		return nil
+	}
+}
+
+// WithSchemaMode sets how Get treats the response fields unknown to the library.
+func WithSchemaMode(mode SchemaMode) ClientOption {
+	return func(p *ClientParams) error {
+		p.SchemaMode = mode
+
+		return nil
+	}
+}
+
+// WithUnknownFieldsHandler sets the function called by Get with the names of unknown response fields.
+// It has effect in the lenient and strict schema modes only.
+func WithUnknownFieldsHandler(fn func(domainName string, fields []string)) ClientOption {
+	return func(p *ClientParams) error {
+		p.OnUnknownFields = fn
+
+		return nil
+	}
+}
+
+// WithResponseValidation makes Get check that the response has the required fields
+// and is for the requested domain name.
+func WithResponseValidation() ClientOption {
+	return func(p *ClientParams) error {
+		p.ValidateResponses = true
+
+		return nil
+	}
+}
+
+// WithDefaultOptions sets the options applied to every Get and GetRaw call before the options of the call.
+func WithDefaultOptions(opts ...Option) ClientOption {
+	return func(p *ClientParams) error {
+		p.DefaultOptions = append(p.DefaultOptions, opts...)
+
+		return nil
+	}
+}
+
+// New creates Client configured by the options. Unlike NewClient it validates the API key and
+// the settings and returns an error instead of panicking.
+func New(apiKey string, opts ...ClientOption) (*Client, error) {
+	if err := validateAPIKey(apiKey); err != nil {
+		return nil, err
+	}
+
+	var params ClientParams
+
+	for _, opt := range opts {
+		if err := opt(&params); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	return newClient(apiKey, params)
+}
+
+// validateAPIKey checks that the API key is not empty and contains only letters, digits and underscores.
+func validateAPIKey(apiKey string) error {
+	if apiKey == "" {
+		return &ArgError{"apiKey", "can not be empty"}
+	}
+
+	for _, r := range apiKey {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_') {
+			return &ArgError{"apiKey", "contains invalid characters"}
+		}
+	}
+
+	return nil
+}
+
+// validate checks the base URL and conflicting settings.
+func (p *ClientParams) validate() error {
+	if u := p.DomainAvailabilityBaseURL; u != nil {
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return &ArgError{"baseURL", "must have http or https scheme"}
+		}
+
+		if u.Host == "" {
+			return &ArgError{"baseURL", "must have a host"}
+		}
+	}
+
+	if p.SchemaMode < SchemaIgnore || p.SchemaMode > SchemaStrict {
+		return &ArgError{"schemaMode", "is unknown"}
+	}
+
+	if p.HTTPClient != nil {
+		if p.Timeout != 0 {
+			return &ArgError{"timeout", "conflicts with the custom HTTP client"}
+		}
+
+		if !p.Transport.isZero() {
+			return &ArgError{"transport", "conflicts with the custom HTTP client"}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package domainavailability
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestNew tests validation of the New options.
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		apiKey  string
+		opts    []ClientOption
+		wantErr string
+	}{
+		{
+			name:    "defaults",
+			apiKey:  apiKey,
+			wantErr: "",
+		},
+		{
+			name:    "all options",
+			apiKey:  apiKey,
+			opts:    []ClientOption{WithBaseURL("http://localhost:8080/api/v1"), WithTimeout(time.Second), WithCoalescing()},
+			wantErr: "",
+		},
+		{
+			name:    "empty key",
+			apiKey:  "",
+			wantErr: `invalid argument: "apiKey" can not be empty`,
+		},
+		{
+			name:    "invalid key",
+			apiKey:  "at_Lorem Ipsum",
+			wantErr: `invalid argument: "apiKey" contains invalid characters`,
+		},
+		{
+			name:    "relative URL",
+			apiKey:  apiKey,
+			opts:    []ClientOption{WithBaseURL("/api/v1")},
+			wantErr: `invalid argument: "baseURL" must have http or https scheme`,
+		},
+		{
+			name:    "URL without host",
+			apiKey:  apiKey,
+			opts:    []ClientOption{WithBaseURL("https:///api/v1")},
+			wantErr: `invalid argument: "baseURL" must have a host`,
+		},
+		{
+			name:    "HTTP client and timeout",
+			apiKey:  apiKey,
+			opts:    []ClientOption{WithHTTPClient(&http.Client{}), WithTimeout(time.Second)},
+			wantErr: `invalid argument: "timeout" conflicts with the custom HTTP client`,
+		},
+		{
+			name:    "HTTP client and transport",
+			apiKey:  apiKey,
+			opts:    []ClientOption{WithHTTPClient(&http.Client{}), WithTransport(TransportParams{DisableHTTP2: true})},
+			wantErr: `invalid argument: "transport" conflicts with the custom HTTP client`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := New(tt.apiKey, tt.opts...)
+			checkErr(t, err, tt.wantErr)
+
+			if tt.wantErr == "" && client == nil {
+				t.Errorf("New() returned nil client")
+			}
+		})
+	}
+}
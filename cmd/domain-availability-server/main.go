@@ -0,0 +1,91 @@
+// Command domain-availability-server runs the REST service for Domain Availability API checks.
+//
+// The client is configured by the config file and the DOMAIN_AVAILABILITY_* environment variables,
+// see the config package.
+// Caller tokens are passed as a comma separated list of name:token pairs.
+package main
+
+import (
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+	"github.com/whois-api-llc/domain-availability-go/config"
+	"github.com/whois-api-llc/domain-availability-go/server"
+)
+
+func main() {
+	var (
+		addr      = flag.String("listen", ":8080", "address to listen on")
+		cfgPath   = flag.String("config", "", "path to the JSON, YAML or TOML config file")
+		tokens    = flag.String("tokens", "", "comma separated list of caller name:token pairs, empty disables authentication")
+		cacheTTL  = flag.Duration("cache-ttl", 10*time.Minute, "time to cache responses, 0 disables caching")
+		cacheSize = flag.Int("cache-size", 10000, "maximum number of cached responses")
+		rateLimit = flag.Float64("rate", 10, "API requests per second allowed for each caller, 0 disables limiting")
+		rateBurst = flag.Int("burst", 20, "maximum number of API requests a caller can make at once")
+		maxBulk   = flag.Int("max-bulk", 100, "maximum number of domain names in a bulk request")
+		workers   = flag.Int("bulk-workers", 8, "number of simultaneous API requests for a bulk request")
+		adaptive  = flag.Bool("adaptive", false, "adapt the number of simultaneous bulk API requests up to -bulk-workers")
+	)
+
+	flag.Parse()
+
+	client, err := config.NewClient(*cfgPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	callers, err := parseTokens(*tokens)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	params := server.Params{
+		Tokens:          callers,
+		CacheTTL:        *cacheTTL,
+		MaxCacheEntries: *cacheSize,
+		RateLimit:       *rateLimit,
+		RateBurst:       *rateBurst,
+		MaxBulk:         *maxBulk,
+		BulkWorkers:     *workers,
+	}
+
+	if *adaptive {
+		params.BulkLimiter = domainavailability.NewAdaptiveLimiter(domainavailability.LimiterParams{MaxLimit: *workers})
+	}
+
+	handler := server.New(client, params)
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Println("listening on", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
+
+// parseTokens parses the list of name:token pairs to the map of tokens to names.
+func parseTokens(s string) (map[string]string, error) {
+	tokens := make(map[string]string)
+
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		i := strings.IndexByte(pair, ':')
+		if i <= 0 || i == len(pair)-1 {
+			return nil, errors.New("invalid token pair: " + pair)
+		}
+
+		tokens[pair[i+1:]] = pair[:i]
+	}
+
+	return tokens, nil
+}
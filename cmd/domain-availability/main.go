@@ -0,0 +1,279 @@
+// Command domain-availability checks domain names with the Domain Availability API.
+//
+// Usage:
+//
+//	domain-availability check [flags] domain...
+//	domain-availability sweep [flags] label...
+//	domain-availability typosquat [flags] domain
+//
+// The client is configured by the config file and the DOMAIN_AVAILABILITY_* environment variables,
+// see the config package.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+	"github.com/whois-api-llc/domain-availability-go/config"
+	"github.com/whois-api-llc/domain-availability-go/sweep"
+	"github.com/whois-api-llc/domain-availability-go/typosquat"
+)
+
+const usage = `usage:
+  domain-availability check [flags] domain...
+  domain-availability sweep [flags] label...
+  domain-availability typosquat [flags] domain
+
+Run "domain-availability <command> -h" for the command flags.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var err error
+
+	switch os.Args[1] {
+	case "check":
+		err = runCheck(ctx, os.Args[2:], os.Stdout)
+	case "sweep":
+		err = runSweep(ctx, os.Args[2:], os.Stdout)
+	case "typosquat":
+		err = runTyposquat(ctx, os.Args[2:], os.Stdout)
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "domain-availability:", err)
+		os.Exit(1)
+	}
+}
+
+// runCheck checks the domain names and prints a line per name.
+func runCheck(ctx context.Context, args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+
+	var (
+		cfgPath  = fs.String("config", "", "path to the JSON, YAML or TOML config file")
+		mode     = fs.String("mode", "", "check mode: DNS_ONLY|DNS_AND_WHOIS")
+		workers  = fs.Int("workers", 8, "number of simultaneous requests")
+		adaptive = fs.Bool("adaptive", false, "adapt the number of simultaneous requests up to -workers")
+	)
+
+	_ = fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		return errors.New("no domain names to check")
+	}
+
+	client, err := config.NewClient(*cfgPath)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	service, n := bulkService(client, *workers, *adaptive)
+
+	for _, res := range domainavailability.GetBulk(ctx, service, fs.Args(), n, modeOptions(*mode)...) {
+		fmt.Fprintf(tw, "%s\t%s\n", res.DomainName, cell(res))
+	}
+
+	return tw.Flush()
+}
+
+// runSweep checks the labels across the TLD set and prints the availability matrix.
+func runSweep(ctx context.Context, args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("sweep", flag.ExitOnError)
+
+	var (
+		cfgPath  = fs.String("config", "", "path to the JSON, YAML or TOML config file")
+		preset   = fs.String("preset", "popular", "TLD preset: "+strings.Join(sweep.Presets(), "|"))
+		tldList  = fs.String("tlds", "", "comma separated list of TLDs, overrides -preset")
+		tldFile  = fs.String("tld-file", "", "file with a TLD per line, overrides -preset and -tlds")
+		mode     = fs.String("mode", "", "check mode: DNS_ONLY|DNS_AND_WHOIS")
+		workers  = fs.Int("workers", 8, "number of simultaneous requests")
+		adaptive = fs.Bool("adaptive", false, "adapt the number of simultaneous requests up to -workers")
+	)
+
+	_ = fs.Parse(args)
+
+	tlds, err := loadTLDs(*preset, *tldList, *tldFile)
+	if err != nil {
+		return err
+	}
+
+	client, err := config.NewClient(*cfgPath)
+	if err != nil {
+		return err
+	}
+
+	service, n := bulkService(client, *workers, *adaptive)
+
+	m, err := sweep.Sweep(ctx, service, fs.Args(), tlds, n, modeOptions(*mode)...)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	fmt.Fprint(tw, "LABEL")
+
+	for _, tld := range m.TLDs {
+		fmt.Fprint(tw, "\t."+tld)
+	}
+
+	fmt.Fprintln(tw)
+
+	for i, label := range m.Labels {
+		fmt.Fprint(tw, label)
+
+		for _, res := range m.Results[i] {
+			fmt.Fprint(tw, "\t"+cell(res))
+		}
+
+		fmt.Fprintln(tw)
+	}
+
+	return tw.Flush()
+}
+
+// runTyposquat checks the look-alike variants of the domain name and prints the registered ones.
+func runTyposquat(ctx context.Context, args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("typosquat", flag.ExitOnError)
+
+	var (
+		cfgPath     = fs.String("config", "", "path to the JSON, YAML or TOML config file")
+		kinds       = fs.String("kinds", "", "comma separated list of permutation techniques, empty means all")
+		tldList     = fs.String("tlds", "", "comma separated list of TLDs for the tld-swap technique")
+		maxVariants = fs.Int("max", 500, "maximum number of variants to check, 0 means no limit")
+		mode        = fs.String("mode", "", "check mode: DNS_ONLY|DNS_AND_WHOIS")
+		workers     = fs.Int("workers", 8, "number of simultaneous requests")
+		adaptive    = fs.Bool("adaptive", false, "adapt the number of simultaneous requests up to -workers")
+	)
+
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return errors.New("exactly one domain name is expected")
+	}
+
+	params := typosquat.Params{MaxVariants: *maxVariants}
+
+	if *kinds != "" {
+		for _, name := range strings.Split(*kinds, ",") {
+			kind, err := typosquat.ParseKind(name)
+			if err != nil {
+				return err
+			}
+
+			params.Kinds = append(params.Kinds, kind)
+		}
+	}
+
+	if *tldList != "" {
+		params.TLDs = strings.Split(*tldList, ",")
+	}
+
+	client, err := config.NewClient(*cfgPath)
+	if err != nil {
+		return err
+	}
+
+	service, n := bulkService(client, *workers, *adaptive)
+
+	report, err := typosquat.Scan(ctx, service, fs.Arg(0), params, n, modeOptions(*mode)...)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	for _, threat := range report.Threats {
+		fmt.Fprintf(tw, "%s\t%s\n", threat.DomainName, threat.Kind)
+	}
+
+	for _, res := range report.Failed {
+		fmt.Fprintf(tw, "%s\tERROR: %v\n", res.DomainName, res.Err)
+	}
+
+	fmt.Fprintf(tw, "%d registered of %d variants\n", len(report.Threats), report.Checked)
+
+	return tw.Flush()
+}
+
+// loadTLDs returns the TLD set from the file, the list or the preset, in this order of precedence.
+func loadTLDs(preset, list, path string) ([]string, error) {
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		return sweep.ReadTLDs(f)
+	}
+
+	if list != "" {
+		return strings.Split(list, ","), nil
+	}
+
+	tlds, ok := sweep.Preset(preset)
+	if !ok {
+		return nil, fmt.Errorf("unknown TLD preset %q", preset)
+	}
+
+	return tlds, nil
+}
+
+// bulkService returns the service and the number of workers for bulk checks,
+// limited by the adaptive limiter if adaptive is set.
+func bulkService(
+	client *domainavailability.Client,
+	workers int,
+	adaptive bool,
+) (domainavailability.DomainAvailabilityService, int) {
+	if !adaptive {
+		return client, workers
+	}
+
+	limiter := domainavailability.NewAdaptiveLimiter(domainavailability.LimiterParams{MaxLimit: workers})
+
+	return domainavailability.Limited(client, limiter), limiter.MaxLimit()
+}
+
+// modeOptions returns the options for the mode flag.
+func modeOptions(mode string) []domainavailability.Option {
+	if mode == "" {
+		return nil
+	}
+
+	return []domainavailability.Option{domainavailability.OptionMode(mode)}
+}
+
+// cell returns the short text for the result.
+func cell(res domainavailability.BulkResult) string {
+	if res.Err != nil {
+		return "ERROR"
+	}
+
+	return res.Availability.String()
+}
@@ -0,0 +1,126 @@
+package domainavailability
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// flight is an in-flight API request shared by concurrent identical calls.
+type flight struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+
+	resp *Response
+	err  error
+}
+
+// flightGroup coalesces concurrent identical API requests into one.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// newFlightGroup creates an empty flightGroup.
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: make(map[string]*flight)}
+}
+
+// do executes fn once for all concurrent callers with the same key and returns its results to each of them.
+// The shared request is canceled only when every waiting caller's context is done.
+// It runs with the values of the caller that started it, e.g. its budget, but not with its deadline.
+// shared is true for the callers which joined the request started by another caller.
+func (g *flightGroup) do(
+	ctx context.Context,
+	key string,
+	fn func(ctx context.Context) (*Response, error),
+) (resp *Response, shared bool, err error) {
+	g.mu.Lock()
+
+	f, shared := g.flights[key]
+	if !shared {
+		flightCtx, cancel := context.WithCancel(detachedContext{ctx})
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.flights[key] = f
+
+		go func() {
+			f.resp, f.err = fn(flightCtx)
+
+			g.mu.Lock()
+			if g.flights[key] == f {
+				delete(g.flights, key)
+			}
+			g.mu.Unlock()
+
+			cancel()
+			close(f.done)
+		}()
+	}
+
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.resp, shared, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			// nobody waits for the result, so later callers must not join the canceled request
+			if g.flights[key] == f {
+				delete(g.flights, key)
+			}
+
+			f.cancel()
+		}
+		g.mu.Unlock()
+
+		return nil, shared, ctx.Err()
+	}
+}
+
+// detachedContext carries the values of the parent context without its deadline and cancellation.
+type detachedContext struct {
+	parent context.Context
+}
+
+// Deadline returns no deadline.
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Done returns nil, so the context is never done.
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err returns nil as the context is never canceled.
+func (detachedContext) Err() error {
+	return nil
+}
+
+// Value returns the value of the parent context.
+func (d detachedContext) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
+
+// coalesceKey returns the key identifying identical requests: the normalized domain name and the query.
+func coalesceKey(domainName string, query url.Values) string {
+	q := make(url.Values, len(query))
+	for k, v := range query {
+		q[k] = v
+	}
+
+	q.Del("domainName")
+
+	return normalizeDomainName(domainName) + "?" + q.Encode()
+}
+
+// normalizeDomainName returns the domain name in lower case without surrounding spaces and the trailing dot.
+func normalizeDomainName(domainName string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domainName)), ".")
+}
@@ -0,0 +1,152 @@
+package domainavailability
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestCoalesceRequests tests that concurrent identical calls share one API request.
+func TestCoalesceRequests(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"UNAVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	var hits int64
+
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt64(&hits, 1)
+		<-release
+		_, _ = w.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	apiURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api := NewClient(apiKey, ClientParams{
+		HTTPClient:                server.Client(),
+		DomainAvailabilityBaseURL: apiURL,
+		CoalesceRequests:          true,
+	})
+
+	domainNames := []string{"whoisxmlapi.com", "WhoisXMLAPI.com", "whoisxmlapi.com.", "whoisxmlapi.com"}
+
+	var wg sync.WaitGroup
+
+	errs := make([]error, len(domainNames)+1)
+
+	for i, domainName := range domainNames {
+		wg.Add(1)
+
+		go func(i int, domainName string) {
+			defer wg.Done()
+
+			_, _, errs[i] = api.Get(context.Background(), domainName)
+		}(i, domainName)
+	}
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		_, _, errs[len(domainNames)] = api.Get(context.Background(), "whoisxmlapi.com", OptionMode("DNS_AND_WHOIS"))
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("call %d error = %v", i, err)
+		}
+	}
+
+	if got := atomic.LoadInt64(&hits); got != 2 {
+		t.Errorf("API requests = %d, want 2", got)
+	}
+}
+
+// TestCoalesceContextBudget tests the coalesced request is charged to the context budget of the call which started it.
+func TestCoalesceContextBudget(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"UNAVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	var hits int64
+
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt64(&hits, 1)
+		<-release
+		_, _ = w.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	apiURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api := NewClient(apiKey, ClientParams{
+		HTTPClient:                server.Client(),
+		DomainAvailabilityBaseURL: apiURL,
+		CoalesceRequests:          true,
+	})
+
+	leader, joiner := NewBudget(BudgetParams{}), NewBudget(BudgetParams{})
+
+	var wg sync.WaitGroup
+
+	errs := make([]error, 2)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		_, _, errs[0] = api.Get(ContextWithBudget(context.Background(), leader), "whoisxmlapi.com")
+	}()
+
+	for atomic.LoadInt64(&hits) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		_, _, errs[1] = api.Get(ContextWithBudget(context.Background(), joiner), "whoisxmlapi.com")
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("call %d error = %v", i, err)
+		}
+	}
+
+	if got := atomic.LoadInt64(&hits); got != 1 {
+		t.Errorf("API requests = %d, want 1", got)
+	}
+
+	if used := leader.Used("WHOIS"); used != 1 {
+		t.Errorf("leader budget Used() = %d, want 1", used)
+	}
+
+	if used := joiner.Used("WHOIS"); used != 0 {
+		t.Errorf("joiner budget Used() = %d, want 0", used)
+	}
+}
@@ -0,0 +1,385 @@
+// Package config builds Domain Availability API clients from environment variables and config files.
+//
+// Values are taken in the following order, later ones override earlier ones:
+// the library defaults, the config file, the environment variables.
+//
+// Supported environment variables:
+//
+//	DOMAIN_AVAILABILITY_API_KEY
+//	DOMAIN_AVAILABILITY_BASE_URL
+//	DOMAIN_AVAILABILITY_TIMEOUT   (Go duration like "10s" or a number of seconds)
+//	DOMAIN_AVAILABILITY_MODE      (DNS_AND_WHOIS|DNS_ONLY)
+//	DOMAIN_AVAILABILITY_CREDITS   (DA|WHOIS)
+//	DOMAIN_AVAILABILITY_CONFIG    (path to the config file used by Load when the path is empty)
+//
+// Config files are JSON, YAML or TOML, chosen by the extension. Keys are the same as the environment
+// variable suffixes in any case, with or without underscores: api_key, apiKey, base_url, timeout, mode, credits.
+//
+// YAML and TOML files are read with a small parser supporting only their common flat subset:
+//
+//   - one "key: value" (YAML) or "key = value" (TOML) pair per line, keys are letters, digits, "_" and "-";
+//   - blank lines, lines starting with "#" and the YAML document start "---" are skipped;
+//   - values are double-quoted strings with backslash escapes, single-quoted strings without escapes,
+//     or bare values which can not start with a quote;
+//   - a value may be followed by a comment starting with "#", after a bare value it must follow a space.
+//
+// Anything else, e.g. indented or nested values, lists, TOML tables, multi-line strings or duplicate keys,
+// fails with an error giving the line number.
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// EnvPrefix is the prefix of the environment variables.
+const EnvPrefix = "DOMAIN_AVAILABILITY_"
+
+// Config is the client configuration.
+type Config struct {
+	// APIKey is the Domain Availability API key.
+	APIKey string
+
+	// BaseURL is the Domain Availability API endpoint, the library default if it's empty.
+	BaseURL string
+
+	// Timeout is the overall request timeout, the library default if it's zero.
+	Timeout time.Duration
+
+	// Mode is the default check mode applied to every call.
+	Mode string
+
+	// Credits is the default type of credits applied to every call.
+	Credits string
+}
+
+// Load reads the config file at the path and then applies the environment variables.
+// If the path is empty then DOMAIN_AVAILABILITY_CONFIG is used, and if it's not set only
+// the environment variables are read.
+func Load(path string) (*Config, error) {
+	if path == "" {
+		path = os.Getenv(EnvPrefix + "CONFIG")
+	}
+
+	cfg := &Config{}
+
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// FromEnv reads the configuration from the environment variables only.
+func FromEnv() (*Config, error) {
+	cfg := &Config{}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// NewClient loads the configuration like Load and creates Client from it.
+func NewClient(path string) (*domainavailability.Client, error) {
+	cfg, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg.NewClient()
+}
+
+// ClientOptions returns the options for domainavailability.New.
+func (cfg *Config) ClientOptions() []domainavailability.ClientOption {
+	var opts []domainavailability.ClientOption
+
+	if cfg.BaseURL != "" {
+		opts = append(opts, domainavailability.WithBaseURL(cfg.BaseURL))
+	}
+
+	if cfg.Timeout != 0 {
+		opts = append(opts, domainavailability.WithTimeout(cfg.Timeout))
+	}
+
+	if defaults := cfg.DefaultOptions(); len(defaults) > 0 {
+		opts = append(opts, domainavailability.WithDefaultOptions(defaults...))
+	}
+
+	return opts
+}
+
+// DefaultOptions returns the options applied to every call.
+func (cfg *Config) DefaultOptions() []domainavailability.Option {
+	var opts []domainavailability.Option
+
+	if cfg.Mode != "" {
+		opts = append(opts, domainavailability.OptionMode(cfg.Mode))
+	}
+
+	if cfg.Credits != "" {
+		opts = append(opts, domainavailability.OptionCredits(cfg.Credits))
+	}
+
+	return opts
+}
+
+// NewClient creates Client from the configuration.
+func (cfg *Config) NewClient(opts ...domainavailability.ClientOption) (*domainavailability.Client, error) {
+	return domainavailability.New(cfg.APIKey, append(cfg.ClientOptions(), opts...)...)
+}
+
+// loadEnv applies the environment variables.
+func (cfg *Config) loadEnv() error {
+	for _, key := range []string{"API_KEY", "BASE_URL", "TIMEOUT", "MODE", "CREDITS"} {
+		if value, ok := os.LookupEnv(EnvPrefix + key); ok && value != "" {
+			if err := cfg.set(key, value); err != nil {
+				return fmt.Errorf("invalid %s%s: %w", EnvPrefix, key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadFile applies the config file.
+func (cfg *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("cannot read config: %w", err)
+	}
+
+	var values map[string]string
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		values, err = parseJSON(data)
+	case ".yaml", ".yml":
+		values, err = parseFlat(data, ':')
+	case ".toml":
+		values, err = parseFlat(data, '=')
+	default:
+		return fmt.Errorf("unsupported config format: %q", ext)
+	}
+
+	if err != nil {
+		return fmt.Errorf("cannot parse config %s: %w", path, err)
+	}
+
+	for key, value := range values {
+		if err = cfg.set(key, value); err != nil {
+			return fmt.Errorf("invalid %q in config %s: %w", key, path, err)
+		}
+	}
+
+	return nil
+}
+
+// set sets the field identified by the key in any case, with or without underscores.
+func (cfg *Config) set(key, value string) error {
+	switch strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key)) {
+	case "apikey":
+		cfg.APIKey = value
+	case "baseurl":
+		cfg.BaseURL = value
+	case "timeout":
+		timeout, err := parseDuration(value)
+		if err != nil {
+			return err
+		}
+
+		cfg.Timeout = timeout
+	case "mode":
+		cfg.Mode = strings.ToUpper(value)
+	case "credits":
+		cfg.Credits = strings.ToUpper(value)
+	default:
+		return errors.New("unknown key")
+	}
+
+	return nil
+}
+
+// parseDuration parses a Go duration or a number of seconds.
+func parseDuration(s string) (time.Duration, error) {
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+
+	return time.ParseDuration(s)
+}
+
+// parseJSON parses the JSON object with string or number values.
+func parseJSON(data []byte) (map[string]string, error) {
+	var raw map[string]json.RawMessage
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(raw))
+
+	for key, msg := range raw {
+		var s string
+		if err := json.Unmarshal(msg, &s); err == nil {
+			values[key] = s
+
+			continue
+		}
+
+		var n json.Number
+		if err := json.Unmarshal(msg, &n); err != nil {
+			return nil, fmt.Errorf("%q must be a string or a number", key)
+		}
+
+		values[key] = n.String()
+	}
+
+	return values, nil
+}
+
+// parseFlat parses the flat key/value lines separated by sep, see the package documentation for the syntax.
+func parseFlat(data []byte, sep byte) (map[string]string, error) {
+	values := make(map[string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for n := 1; scanner.Scan(); n++ {
+		key, value, ok, err := parseLine(scanner.Text(), sep)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		if _, dup := values[key]; dup {
+			return nil, fmt.Errorf("line %d: duplicate key %q", n, key)
+		}
+
+		values[key] = value
+	}
+
+	return values, scanner.Err()
+}
+
+// parseLine parses the key/value line. It returns false for blank and comment lines.
+func parseLine(line string, sep byte) (key, value string, ok bool, err error) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || trimmed[0] == '#' || trimmed == "---" {
+		return "", "", false, nil
+	}
+
+	if line[0] == ' ' || line[0] == '\t' {
+		return "", "", false, errors.New("indented lines are not supported")
+	}
+
+	i := strings.IndexByte(trimmed, sep)
+	if i <= 0 {
+		return "", "", false, fmt.Errorf("expected key %c value", sep)
+	}
+
+	key = strings.TrimSpace(trimmed[:i])
+	if !isKey(key) {
+		return "", "", false, fmt.Errorf("invalid key %q", key)
+	}
+
+	value, err = parseValue(strings.TrimSpace(trimmed[i+1:]))
+	if err != nil {
+		return "", "", false, err
+	}
+
+	return key, value, true, nil
+}
+
+// isKey reports whether s consists of letters, digits, "_" and "-".
+func isKey(s string) bool {
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// parseValue parses the quoted or bare value followed by an optional comment.
+func parseValue(s string) (string, error) {
+	if s == "" || s[0] == '#' {
+		return "", errors.New("missing value, nested values are not supported")
+	}
+
+	var (
+		value string
+		rest  string
+	)
+
+	switch s[0] {
+	case '"':
+		end := closingQuote(s)
+		if end < 0 {
+			return "", errors.New("unterminated string")
+		}
+
+		unquoted, err := strconv.Unquote(s[:end+1])
+		if err != nil {
+			return "", fmt.Errorf("invalid string %s: %w", s[:end+1], err)
+		}
+
+		value, rest = unquoted, s[end+1:]
+	case '\'':
+		end := strings.IndexByte(s[1:], '\'')
+		if end < 0 {
+			return "", errors.New("unterminated string")
+		}
+
+		value, rest = s[1:end+1], s[end+2:]
+	default:
+		for i := 1; i < len(s); i++ {
+			if s[i] == '#' && (s[i-1] == ' ' || s[i-1] == '\t') {
+				return strings.TrimSpace(s[:i]), nil
+			}
+		}
+
+		return s, nil
+	}
+
+	if rest = strings.TrimSpace(rest); rest != "" && rest[0] != '#' {
+		return "", fmt.Errorf("unexpected %q after the string", rest)
+	}
+
+	return value, nil
+}
+
+// closingQuote returns the index of the double quote closing the string starting at s[0], or -1.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestLoad tests loading the config files of every format and the environment precedence.
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"config.json": `{"api_key":"at_FromFile","baseURL":"https://example.com/api","timeout":15,"mode":"dns_and_whois"}`,
+		"config.yaml": "# comment\napi_key: \"at_FromFile\"\nbase_url: https://example.com/api # comment\ntimeout: 15s\nmode: dns_and_whois\n",
+		"config.toml": "api_key = \"at_FromFile\"\nbase_url = 'https://example.com/api'\ntimeout = \"15s\"\nmode = \"DNS_AND_WHOIS\"\n",
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			t.Setenv(EnvPrefix+"API_KEY", "")
+			t.Setenv(EnvPrefix+"CREDITS", "da")
+
+			cfg, err := Load(path)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			want := Config{
+				APIKey:  "at_FromFile",
+				BaseURL: "https://example.com/api",
+				Timeout: 15 * time.Second,
+				Mode:    "DNS_AND_WHOIS",
+				Credits: "DA",
+			}
+			if *cfg != want {
+				t.Errorf("Load() = %+v, want %+v", *cfg, want)
+			}
+
+			t.Setenv(EnvPrefix+"API_KEY", "at_FromEnv")
+
+			if cfg, err = Load(path); err != nil || cfg.APIKey != "at_FromEnv" {
+				t.Errorf("Load() = %+v, %v, want the API key from the environment", cfg, err)
+			}
+
+			if _, err = cfg.NewClient(); err != nil {
+				t.Errorf("Config.NewClient() error = %v", err)
+			}
+		})
+	}
+}
+
+// TestLoadErrors tests the invalid configurations.
+func TestLoadErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"unknown.json":       `{"apiKey":"at_FromFile","color":"red"}`,
+		"broken.yaml":        "apiKey\n",
+		"timeout.toml":       "timeout = \"soon\"\n",
+		"config.ini":         "apiKey=at_FromFile\n",
+		"unterminated.yaml":  "api_key: \"at_FromFile\n",
+		"trailing.yaml":      "api_key: \"at_From\"File\n",
+		"nested.yaml":        "client:\n  api_key: at_FromFile\n",
+		"list.yaml":          "- api_key: at_FromFile\n",
+		"duplicate.toml":     "api_key = \"a\"\napi_key = \"b\"\n",
+		"table.toml":         "[client]\napi_key = \"at_FromFile\"\n",
+		"multiline.toml":     "api_key = \"\"\"\nat_FromFile\"\"\"\n",
+		"bad-escape.toml":    "api_key = \"at_\\q\"\n",
+		"inline-table.toml":  "client = { api_key = \"at_FromFile\" }\n",
+		"quoted-key.toml":    "\"api_key\" = \"at_FromFile\"\n",
+		"single-quoted.yaml": "api_key: 'at_From'File'\n",
+		"after-comment.toml": "# ok\napi_key = \"at_FromFile\" trailing\n",
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := Load(path); err == nil {
+				t.Errorf("Load() error = nil, want error")
+			}
+		})
+	}
+}
+
+// TestParseFlat tests the supported YAML and TOML subset and the line numbers of errors.
+func TestParseFlat(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		sep     byte
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "quoted value with a comment containing quotes",
+			data: "api_key: \"at_Key\" # the \"test\" key\nmode: 'dns_only' # it's cheaper\n",
+			sep:  ':',
+			want: map[string]string{"api_key": "at_Key", "mode": "dns_only"},
+		},
+		{
+			name: "quotes and hashes inside values",
+			data: "api_key = \"at_#\\\"Key\\\"\"\nbase_url = https://example.com/api#anchor # comment\n",
+			sep:  '=',
+			want: map[string]string{"api_key": `at_#"Key"`, "base_url": "https://example.com/api#anchor"},
+		},
+		{
+			name: "comments and document start",
+			data: "---\n# comment\n\n  # indented comment\ntimeout: 15\t# seconds\n",
+			sep:  ':',
+			want: map[string]string{"timeout": "15"},
+		},
+		{
+			name:    "error line number",
+			data:    "# comment\napi_key = \"at_Key\"\nmode = \"dns_only\" \"extra\"\n",
+			sep:     '=',
+			wantErr: `line 3: unexpected "\"extra\"" after the string`,
+		},
+		{
+			name:    "empty value",
+			data:    "api_key:\n",
+			sep:     ':',
+			wantErr: "line 1: missing value, nested values are not supported",
+		},
+		{
+			name:    "indented line",
+			data:    "mode: dns_only\n  credits: da\n",
+			sep:     ':',
+			wantErr: "line 2: indented lines are not supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFlat([]byte(tt.data), tt.sep)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("parseFlat() error = %v, want %s", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseFlat() error = %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Errorf("parseFlat() = %v, want %v", got, tt.want)
+			}
+
+			for key, value := range tt.want {
+				if got[key] != value {
+					t.Errorf("parseFlat() %s = %q, want %q", key, got[key], value)
+				}
+			}
+		})
+	}
+}
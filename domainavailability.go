@@ -3,10 +3,11 @@ package domainavailability
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // DomainAvailabilityService is an interface for Domain Availability API.
@@ -24,12 +25,24 @@ type Response struct {
 
 	// Body is the byte slice representation of http.Response Body
 	Body []byte
+
+	// Meta describes how and when the response was obtained
+	Meta ResponseMeta
+
+	// decoded is the response decoded while streaming, nil if the body was buffered
+	decoded *apiResponse
+
+	// decodeErr is the error of decoding while streaming
+	decodeErr error
 }
 
 // domainAvailabilityServiceOp is the type implementing the DomainAvailability interface.
 type domainAvailabilityServiceOp struct {
 	client  *Client
 	baseURL *url.URL
+
+	// flights coalesces concurrent identical requests, nil if coalescing is disabled
+	flights *flightGroup
 }
 
 var _ DomainAvailabilityService = &domainAvailabilityServiceOp{}
@@ -45,6 +58,7 @@ func (service domainAvailabilityServiceOp) newRequest() (*http.Request, error) {
 	query.Set("apiKey", service.client.apiKey)
 
 	req.URL.RawQuery = query.Encode()
+	req.Header.Set(requestIDHeader, newRequestID())
 
 	return req, nil
 }
@@ -53,10 +67,19 @@ func (service domainAvailabilityServiceOp) newRequest() (*http.Request, error) {
 type apiResponse struct {
 	DomainAvailabilityResponse `json:"DomainInfo"`
 	ErrorMessage               `json:"ErrorMessage"`
+
+	// unknownFields are the sorted names of DomainInfo fields the library doesn't know
+	unknownFields []string
 }
 
 // request returns intermediate API response for further actions.
-func (service domainAvailabilityServiceOp) request(ctx context.Context, domainName string, opts ...Option) (*Response, error) {
+// If decode is set and the client streams responses, the body is decoded instead of saved.
+func (service domainAvailabilityServiceOp) request(
+	ctx context.Context,
+	domainName string,
+	decode bool,
+	opts ...Option,
+) (*Response, error) {
 	if domainName == "" {
 		return nil, &ArgError{"domainName", "can not be empty"}
 	}
@@ -67,43 +90,129 @@ func (service domainAvailabilityServiceOp) request(ctx context.Context, domainNa
 	}
 
 	q := req.URL.Query()
-	q.Set("domainName", domainName)
-
-	for _, opt := range opts {
-		opt(q)
+	for k, v := range service.client.EffectiveQuery(domainName, opts...) {
+		q[k] = v
 	}
 
 	req.URL.RawQuery = q.Encode()
 
-	var b bytes.Buffer
+	stream := decode && service.client.stream
+
+	if service.flights != nil {
+		key := coalesceKey(domainName, q)
+		if stream {
+			key += "#decoded"
+		}
+
+		resp, shared, err := service.flights.do(ctx, key, func(ctx context.Context) (*Response, error) {
+			return service.send(ctx, req, stream)
+		})
+
+		if shared && resp != nil {
+			sharedResp := *resp
+			sharedResp.Meta.Source = SourceCoalesced
+			resp = &sharedResp
+		}
+
+		return resp, err
+	}
+
+	return service.send(ctx, req, stream)
+}
+
+// send executes the API request, hedged if the client has the hedger.
+func (service domainAvailabilityServiceOp) send(ctx context.Context, req *http.Request, stream bool) (*Response, error) {
+	do := func(ctx context.Context, req *http.Request) (*Response, error) {
+		return service.do(ctx, req, stream)
+	}
+
+	if service.client.hedger != nil {
+		return service.client.hedger.do(ctx, req, do)
+	}
 
-	resp, err := service.client.Do(ctx, req, &b)
+	return do(ctx, req)
+}
+
+// do executes the API request charging its cost to the budgets.
+// If stream is set, the body is decoded while reading instead of saved to Response.Body.
+func (service domainAvailabilityServiceOp) do(ctx context.Context, req *http.Request, stream bool) (*Response, error) {
+	refund, err := service.client.charge(ctx, req.URL.Query().Get)
 	if err != nil {
-		return &Response{
-			Response: resp,
-			Body:     b.Bytes(),
-		}, err
+		return nil, err
 	}
 
-	return &Response{
-		Response: resp,
-		Body:     b.Bytes(),
-	}, nil
+	result := &Response{}
+
+	consume := func(body io.Reader) error {
+		b := getBuffer()
+		defer putBuffer(b)
+
+		_, err := b.ReadFrom(body)
+		if b.Len() > 0 {
+			result.Body = append([]byte(nil), b.Bytes()...)
+		}
+
+		return err
+	}
+
+	if stream {
+		consume = func(body io.Reader) error {
+			r := &errReader{r: body}
+
+			switch decoded, err := decodeResponse(r, service.client.schemaMode); {
+			case r.err != nil:
+				return r.err
+			case err != nil:
+				result.decodeErr = fmt.Errorf("cannot parse response: %w", err)
+			default:
+				result.decoded = decoded
+			}
+
+			return nil
+		}
+	}
+
+	start := time.Now()
+
+	resp, err := service.client.do(ctx, req, consume)
+	if err != nil || checkResponse(resp) != nil {
+		refund()
+	}
+
+	result.Response = resp
+	result.Meta = newResponseMeta(req, resp, start)
+
+	return result, err
 }
 
 // parse parses raw Domain Availability API response.
-func parse(raw []byte) (*apiResponse, error) {
-	var response apiResponse
-
-	err := json.NewDecoder(bytes.NewReader(raw)).Decode(&response)
+func parse(raw []byte, mode SchemaMode) (*apiResponse, error) {
+	response, err := decodeResponse(bytes.NewReader(raw), mode)
 	if err != nil {
 		return nil, fmt.Errorf("cannot parse response: %w", err)
 	}
 
-	return &response, nil
+	return response, nil
+}
+
+// errReader saves the read error other than io.EOF, so it can be told apart from decoding errors.
+type errReader struct {
+	r   io.Reader
+	err error
+}
+
+// Read reads from the underlying reader.
+func (e *errReader) Read(p []byte) (int, error) {
+	n, err := e.r.Read(p)
+	if err != nil && err != io.EOF {
+		e.err = err
+	}
+
+	return n, err
 }
 
 // Get returns parsed Domain Availability API response.
+// API errors are returned as ErrorMessage together with the Response, so its status code is available.
 func (service domainAvailabilityServiceOp) Get(
 	ctx context.Context,
 	domainName string,
@@ -113,24 +222,50 @@ func (service domainAvailabilityServiceOp) Get(
 	optsJSON = append(optsJSON, opts...)
 	optsJSON = append(optsJSON, OptionOutputFormat("JSON"))
 
-	resp, err = service.request(ctx, domainName, optsJSON...)
+	resp, err = service.request(ctx, domainName, true, optsJSON...)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	domainAvailabilityResp, err := parse(resp.Body)
+	domainAvailabilityResp, err := resp.decoded, resp.decodeErr
+	if domainAvailabilityResp == nil && err == nil {
+		domainAvailabilityResp, err = parse(resp.Body, service.client.schemaMode)
+	}
+
 	if err != nil {
 		return nil, resp, err
 	}
 
 	if domainAvailabilityResp.Message != "" || domainAvailabilityResp.Code != "" {
-		return nil, nil, &ErrorMessage{
+		return nil, resp, &ErrorMessage{
 			Code:    domainAvailabilityResp.Code,
 			Message: domainAvailabilityResp.Message,
 		}
 	}
 
-	return &domainAvailabilityResp.DomainAvailabilityResponse, resp, nil
+	if fields := domainAvailabilityResp.unknownFields; len(fields) > 0 {
+		if service.client.onUnknownFields != nil {
+			service.client.onUnknownFields(domainName, fields)
+		}
+
+		if service.client.schemaMode == SchemaStrict {
+			return nil, resp, &UnknownFieldsError{Fields: fields}
+		}
+	}
+
+	if service.client.validate {
+		if err := ValidateResponse(domainName, &domainAvailabilityResp.DomainAvailabilityResponse); err != nil {
+			return nil, resp, err
+		}
+	}
+
+	// The decoded response may be shared by coalesced calls, so every call gets its own deep copy
+	// including Extra and IsAvailable.
+	result := domainAvailabilityResp.DomainAvailabilityResponse.clone()
+	meta := resp.Meta
+	result.Meta = &meta
+
+	return result, resp, nil
 }
 
 // GetRaw returns raw Domain Availability API response as the Response struct with Body saved as a byte slice.
@@ -139,7 +274,7 @@ func (service domainAvailabilityServiceOp) GetRaw(
 	domainName string,
 	opts ...Option,
 ) (resp *Response, err error) {
-	resp, err = service.request(ctx, domainName, opts...)
+	resp, err = service.request(ctx, domainName, false, opts...)
 	if err != nil {
 		return resp, err
 	}
@@ -0,0 +1,91 @@
+package domainavailability
+
+import "context"
+
+// EscalationParams is used by GetEscalating. None of parameters are mandatory.
+type EscalationParams struct {
+	// EscalateOn lists the DNS_ONLY outcomes which are re-verified with DNS_AND_WHOIS
+	// If it's empty then only Available is re-verified
+	EscalateOn []Availability
+}
+
+// EscalatedResponse is the combined result of the two-phase check.
+type EscalatedResponse struct {
+	// DomainAvailabilityResponse is the final answer of the phase which decided.
+	*DomainAvailabilityResponse
+
+	// DNSOnly is the answer of the fast DNS_ONLY phase.
+	DNSOnly *DomainAvailabilityResponse
+
+	// DNSAndWHOIS is the answer of the accurate DNS_AND_WHOIS phase, nil if it wasn't needed.
+	DNSAndWHOIS *DomainAvailabilityResponse
+
+	// DecidedBy is the mode of the phase which decided: DNS_ONLY|DNS_AND_WHOIS.
+	DecidedBy string
+}
+
+// Escalated reports whether the DNS_AND_WHOIS phase was run.
+func (r *EscalatedResponse) Escalated() bool {
+	return r.DNSAndWHOIS != nil
+}
+
+// GetEscalating checks the domain name with the fast DNS_ONLY mode first and re-verifies
+// the outcomes listed in params with the accurate DNS_AND_WHOIS mode. The mode set by the options is ignored.
+// If the second phase fails, the result of the first phase is returned along with the error.
+func GetEscalating(
+	ctx context.Context,
+	service DomainAvailabilityService,
+	domainName string,
+	params EscalationParams,
+	opts ...Option,
+) (*EscalatedResponse, error) {
+	escalateOn := params.EscalateOn
+	if len(escalateOn) == 0 {
+		escalateOn = []Availability{Available}
+	}
+
+	first, _, err := service.Get(ctx, domainName, withMode(opts, ModeDNSOnly)...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &EscalatedResponse{
+		DomainAvailabilityResponse: first,
+		DNSOnly:                    first,
+		DecidedBy:                  ModeDNSOnly,
+	}
+
+	if !containsAvailability(escalateOn, first.Availability) {
+		return result, nil
+	}
+
+	second, _, err := service.Get(ctx, domainName, withMode(opts, ModeDNSAndWHOIS)...)
+	if err != nil {
+		return result, err
+	}
+
+	result.DomainAvailabilityResponse = second
+	result.DNSAndWHOIS = second
+	result.DecidedBy = ModeDNSAndWHOIS
+
+	return result, nil
+}
+
+// withMode returns a copy of the options with the mode option appended, so it overrides any other.
+func withMode(opts []Option, mode string) []Option {
+	withMode := make([]Option, 0, len(opts)+1)
+	withMode = append(withMode, opts...)
+
+	return append(withMode, OptionMode(mode))
+}
+
+// containsAvailability reports whether the list contains the state regardless of case.
+func containsAvailability(list []Availability, a Availability) bool {
+	for _, v := range list {
+		if v.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
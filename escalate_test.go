@@ -0,0 +1,78 @@
+package domainavailability
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGetEscalating tests the two-phase check.
+func TestGetEscalating(t *testing.T) {
+	var modes []string
+
+	// DNS_ONLY says every name is available, DNS_AND_WHOIS says only "free.com" is.
+	// States of "lower.com" are lower or mixed case.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		modes = append(modes, q.Get("mode"))
+
+		availability := "AVAILABLE"
+		if q.Get("mode") == ModeDNSAndWHOIS && q.Get("domainName") != "free.com" {
+			availability = "UNAVAILABLE"
+		}
+
+		if q.Get("domainName") == "lower.com" {
+			availability = "available"
+			if q.Get("mode") == ModeDNSAndWHOIS {
+				availability = "Unavailable"
+			}
+		}
+
+		_, _ = w.Write([]byte(`{"DomainInfo":{"domainAvailability":"` + availability +
+			`","domainName":"` + q.Get("domainName") + `"}}`))
+	}))
+	defer server.Close()
+
+	client, err := New(apiKey, WithHTTPClient(server.Client()), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	res, err := GetEscalating(ctx, client, "taken.com", EscalationParams{}, OptionMode(ModeDNSAndWHOIS))
+	if err != nil {
+		t.Fatalf("GetEscalating() error = %v", err)
+	}
+
+	if !res.Escalated() || res.DecidedBy != ModeDNSAndWHOIS || !res.Availability.IsUnavailable() ||
+		!res.DNSOnly.Availability.IsAvailable() {
+		t.Errorf("GetEscalating() = %+v, want UNAVAILABLE decided by DNS_AND_WHOIS", res)
+	}
+
+	if len(modes) != 2 || modes[0] != ModeDNSOnly || modes[1] != ModeDNSAndWHOIS {
+		t.Errorf("requested modes = %v, want [DNS_ONLY DNS_AND_WHOIS]", modes)
+	}
+
+	modes = nil
+
+	res, err = GetEscalating(ctx, client, "free.com", EscalationParams{EscalateOn: []Availability{Unavailable}})
+	if err != nil {
+		t.Fatalf("GetEscalating() error = %v", err)
+	}
+
+	if res.Escalated() || res.DecidedBy != ModeDNSOnly || !res.Availability.IsAvailable() || len(modes) != 1 {
+		t.Errorf("GetEscalating() = %+v, want AVAILABLE decided by DNS_ONLY", res)
+	}
+	modes = nil
+
+	res, err = GetEscalating(ctx, client, "lower.com", EscalationParams{})
+	if err != nil {
+		t.Fatalf("GetEscalating() error = %v", err)
+	}
+
+	if !res.Escalated() || res.DecidedBy != ModeDNSAndWHOIS || !res.Availability.IsUnavailable() || len(modes) != 2 {
+		t.Errorf("GetEscalating() of lower case states = %+v, want Unavailable decided by DNS_AND_WHOIS", res)
+	}
+}
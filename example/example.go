@@ -29,12 +29,13 @@ func GetData(apikey string) {
 	}
 
 	// Then print the domain registration state.
-	if domainAvailabilityResp.IsAvailable != nil {
-		if *domainAvailabilityResp.IsAvailable {
-			log.Println(domainAvailabilityResp.DomainName, "is available")
-		} else {
-			log.Println(domainAvailabilityResp.DomainName, "is unavailable")
-		}
+	switch availability := domainAvailabilityResp.Availability; {
+	case availability.IsAvailable():
+		log.Println(domainAvailabilityResp.DomainName, "is available")
+	case availability.IsUnavailable():
+		log.Println(domainAvailabilityResp.DomainName, "is unavailable")
+	default:
+		log.Println(domainAvailabilityResp.DomainName, "state is", availability)
 	}
 
 	log.Println("raw response is always in JSON format. Most likely you don't need it.")
@@ -0,0 +1,317 @@
+// Package export writes availability results as CSV, TSV, Excel-compatible CSV and JSON Lines.
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// Column is the name of an exported field.
+type Column string
+
+// Available columns.
+const (
+	ColumnDomain       Column = "domain"
+	ColumnAvailability Column = "availability"
+	ColumnMode         Column = "mode"
+	ColumnCredits      Column = "credits"
+	ColumnCheckedAt    Column = "checked_at"
+	ColumnErrorCode    Column = "error_code"
+	ColumnErrorMessage Column = "error_message"
+	ColumnSource       Column = "source"
+)
+
+// DefaultColumns are used when no columns are specified.
+var DefaultColumns = []Column{
+	ColumnDomain,
+	ColumnAvailability,
+	ColumnMode,
+	ColumnCheckedAt,
+	ColumnErrorCode,
+	ColumnErrorMessage,
+	ColumnSource,
+}
+
+// Row is a single exported result.
+type Row struct {
+	// DomainName is the checked domain name.
+	DomainName string
+
+	// Availability is the registration state.
+	Availability domainavailability.Availability
+
+	// Mode is the check mode: DNS_AND_WHOIS|DNS_ONLY.
+	Mode string
+
+	// Credits is the type of credits used: DA|WHOIS.
+	Credits string
+
+	// CheckedAt is the time of the check.
+	CheckedAt time.Time
+
+	// ErrorCode is the API error code if the check failed.
+	ErrorCode string
+
+	// ErrorMessage is the error message if the check failed.
+	ErrorMessage string
+
+	// Source tells where the result came from, e.g. the API, a cache or a zone file.
+	Source string
+}
+
+// NewRow builds Row from the results of the Get call. The mode, credits, check time and source
+// are taken from the response metadata, the check time is set to now if there's no metadata.
+func NewRow(domainName string, domainAvailabilityResp *domainavailability.DomainAvailabilityResponse, err error) Row {
+	row := Row{
+		DomainName: domainName,
+		CheckedAt:  time.Now().UTC(),
+	}
+
+	if domainAvailabilityResp != nil {
+		row.Availability = domainAvailabilityResp.Availability
+
+		if meta := domainAvailabilityResp.Meta; meta != nil {
+			row.Mode = meta.Mode
+			row.Credits = meta.Credits
+			row.CheckedAt = meta.CheckedAt
+			row.Source = string(meta.Source)
+		}
+	}
+
+	if err != nil {
+		var apiErr *domainavailability.ErrorMessage
+		if errors.As(err, &apiErr) {
+			row.ErrorCode = apiErr.Code
+			row.ErrorMessage = apiErr.Message
+		} else {
+			row.ErrorMessage = err.Error()
+		}
+	}
+
+	return row
+}
+
+// NewRowFromBulk builds Row from the GetBulk result.
+func NewRowFromBulk(res domainavailability.BulkResult) Row {
+	return NewRow(res.DomainName, res.DomainAvailabilityResponse, res.Err)
+}
+
+// value returns the text representation of the column.
+func (r *Row) value(c Column) string {
+	switch c {
+	case ColumnDomain:
+		return r.DomainName
+	case ColumnAvailability:
+		if r.Availability.IsUnknown() {
+			return ""
+		}
+
+		return r.Availability.String()
+	case ColumnMode:
+		return r.Mode
+	case ColumnCredits:
+		return r.Credits
+	case ColumnCheckedAt:
+		if r.CheckedAt.IsZero() {
+			return ""
+		}
+
+		return r.CheckedAt.Format(time.RFC3339)
+	case ColumnErrorCode:
+		return r.ErrorCode
+	case ColumnErrorMessage:
+		return r.ErrorMessage
+	case ColumnSource:
+		return r.Source
+	}
+
+	return ""
+}
+
+// Writer is an interface for the streaming result writers.
+type Writer interface {
+	// Write writes the row.
+	Write(row Row) error
+
+	// Flush writes any buffered data, including the header if no rows were written.
+	Flush() error
+}
+
+// delimitedWriter writes rows as delimiter separated values with the header line.
+type delimitedWriter struct {
+	w           *csv.Writer
+	columns     []Column
+	wroteHeader bool
+	excel       bool
+}
+
+// NewCSVWriter creates Writer producing comma separated values.
+func NewCSVWriter(w io.Writer, columns ...Column) Writer {
+	return newDelimitedWriter(w, ',', columns)
+}
+
+// NewTSVWriter creates Writer producing tab separated values.
+func NewTSVWriter(w io.Writer, columns ...Column) Writer {
+	return newDelimitedWriter(w, '\t', columns)
+}
+
+// NewExcelCSVWriter creates Writer producing CSV which spreadsheet applications open correctly:
+// UTF-8 with the byte order mark, CRLF line endings and cells which could be taken
+// for formulas escaped with a leading apostrophe.
+func NewExcelCSVWriter(w io.Writer, columns ...Column) Writer {
+	dw := newDelimitedWriter(&bomWriter{w: w}, ',', columns)
+	dw.w.UseCRLF = true
+	dw.excel = true
+
+	return dw
+}
+
+// newDelimitedWriter creates delimitedWriter with the default columns if none are specified.
+func newDelimitedWriter(w io.Writer, comma rune, columns []Column) *delimitedWriter {
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+
+	return &delimitedWriter{w: cw, columns: columns}
+}
+
+// Write writes the row, preceded by the header if it's the first one.
+func (d *delimitedWriter) Write(row Row) error {
+	if err := d.writeHeader(); err != nil {
+		return err
+	}
+
+	record := make([]string, len(d.columns))
+	for i, c := range d.columns {
+		record[i] = row.value(c)
+		if d.excel {
+			record[i] = escapeFormula(record[i])
+		}
+	}
+
+	return d.w.Write(record)
+}
+
+// Flush writes the header if needed and flushes the buffered data.
+func (d *delimitedWriter) Flush() error {
+	if err := d.writeHeader(); err != nil {
+		return err
+	}
+
+	d.w.Flush()
+
+	return d.w.Error()
+}
+
+// writeHeader writes the column names once.
+func (d *delimitedWriter) writeHeader() error {
+	if d.wroteHeader {
+		return nil
+	}
+
+	d.wroteHeader = true
+
+	header := make([]string, len(d.columns))
+	for i, c := range d.columns {
+		header[i] = string(c)
+	}
+
+	return d.w.Write(header)
+}
+
+// escapeFormula prefixes the cell starting with a formula character with an apostrophe.
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+
+	return s
+}
+
+// bomWriter writes the UTF-8 byte order mark before the first write.
+type bomWriter struct {
+	w       io.Writer
+	written bool
+}
+
+// Write writes p preceded by the byte order mark on the first call.
+func (b *bomWriter) Write(p []byte) (int, error) {
+	if !b.written {
+		b.written = true
+
+		if _, err := b.w.Write([]byte("\xEF\xBB\xBF")); err != nil {
+			return 0, err
+		}
+	}
+
+	return b.w.Write(p)
+}
+
+// jsonlWriter writes rows as JSON objects, one per line.
+type jsonlWriter struct {
+	w       io.Writer
+	columns []Column
+	buf     bytes.Buffer
+}
+
+// NewJSONLWriter creates Writer producing JSON Lines. Each line is an object with the column names as keys
+// in the order of the columns, availability is null if it's unknown.
+func NewJSONLWriter(w io.Writer, columns ...Column) Writer {
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
+
+	return &jsonlWriter{w: w, columns: columns}
+}
+
+// Write writes the row as a JSON object.
+func (j *jsonlWriter) Write(row Row) error {
+	j.buf.Reset()
+	j.buf.WriteByte('{')
+
+	for i, c := range j.columns {
+		if i > 0 {
+			j.buf.WriteByte(',')
+		}
+
+		var value interface{} = row.value(c)
+		if c == ColumnAvailability {
+			value = row.Availability
+		}
+
+		key, err := json.Marshal(string(c))
+		if err != nil {
+			return err
+		}
+
+		val, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+
+		j.buf.Write(key)
+		j.buf.WriteByte(':')
+		j.buf.Write(val)
+	}
+
+	j.buf.WriteString("}\n")
+
+	_, err := j.w.Write(j.buf.Bytes())
+
+	return err
+}
+
+// Flush does nothing as JSON lines are not buffered.
+func (j *jsonlWriter) Flush() error {
+	return nil
+}
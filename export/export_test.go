@@ -0,0 +1,79 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// testRows returns the rows used by the writer tests.
+func testRows() []Row {
+	checkedAt := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	return []Row{
+		{DomainName: "whoisxmlapi.com", Availability: domainavailability.Unavailable, Mode: "DNS_ONLY", CheckedAt: checkedAt, Source: "api"},
+		{DomainName: "example-free.com", Availability: domainavailability.Available, Mode: "DNS_AND_WHOIS", CheckedAt: checkedAt},
+		{DomainName: "=cmd.com", ErrorCode: "WHOIS_00", ErrorMessage: "Test, error message."},
+	}
+}
+
+// TestWriters tests the output of every writer.
+func TestWriters(t *testing.T) {
+	columns := []Column{ColumnDomain, ColumnAvailability, ColumnCheckedAt, ColumnErrorCode, ColumnErrorMessage}
+
+	tests := []struct {
+		name      string
+		newWriter func(b *bytes.Buffer) Writer
+		want      string
+	}{
+		{
+			name:      "csv",
+			newWriter: func(b *bytes.Buffer) Writer { return NewCSVWriter(b, columns...) },
+			want: "domain,availability,checked_at,error_code,error_message\n" +
+				"whoisxmlapi.com,UNAVAILABLE,2022-01-02T03:04:05Z,,\n" +
+				"example-free.com,AVAILABLE,2022-01-02T03:04:05Z,,\n" +
+				"=cmd.com,,,WHOIS_00,\"Test, error message.\"\n",
+		},
+		{
+			name:      "tsv",
+			newWriter: func(b *bytes.Buffer) Writer { return NewTSVWriter(b, ColumnDomain, ColumnAvailability) },
+			want:      "domain\tavailability\nwhoisxmlapi.com\tUNAVAILABLE\nexample-free.com\tAVAILABLE\n=cmd.com\t\n",
+		},
+		{
+			name:      "excel",
+			newWriter: func(b *bytes.Buffer) Writer { return NewExcelCSVWriter(b, ColumnDomain, ColumnAvailability) },
+			want: "\xEF\xBB\xBFdomain,availability\r\nwhoisxmlapi.com,UNAVAILABLE\r\n" +
+				"example-free.com,AVAILABLE\r\n'=cmd.com,\r\n",
+		},
+		{
+			name:      "jsonl",
+			newWriter: func(b *bytes.Buffer) Writer { return NewJSONLWriter(b, ColumnDomain, ColumnAvailability) },
+			want: `{"domain":"whoisxmlapi.com","availability":"UNAVAILABLE"}` + "\n" +
+				`{"domain":"example-free.com","availability":"AVAILABLE"}` + "\n" +
+				`{"domain":"=cmd.com","availability":null}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+
+			w := tt.newWriter(&b)
+
+			for _, row := range testRows() {
+				if err := w.Write(row); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+			}
+
+			if err := w.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+
+			if got := b.String(); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
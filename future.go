@@ -0,0 +1,93 @@
+package domainavailability
+
+import (
+	"context"
+	"reflect"
+)
+
+// Future is the result of the check started by GetAsync.
+type Future struct {
+	// DomainName is the requested domain name.
+	DomainName string
+
+	done   chan struct{}
+	cancel context.CancelFunc
+
+	domainAvailabilityResp *DomainAvailabilityResponse
+	resp                   *Response
+	err                    error
+}
+
+// GetAsync starts Get in a new goroutine and returns its Future immediately.
+// Canceling the context or calling Future.Cancel cancels the request.
+func (c *Client) GetAsync(ctx context.Context, domainName string, opts ...Option) *Future {
+	ctx, cancel := context.WithCancel(ctx)
+
+	f := &Future{
+		DomainName: domainName,
+		done:       make(chan struct{}),
+		cancel:     cancel,
+	}
+
+	go func() {
+		defer cancel()
+		defer close(f.done)
+
+		f.domainAvailabilityResp, f.resp, f.err = c.Get(ctx, domainName, opts...)
+	}()
+
+	return f
+}
+
+// Done returns the channel closed when the result is ready.
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait blocks until the result is ready and returns the results of Get.
+func (f *Future) Wait() (*DomainAvailabilityResponse, *Response, error) {
+	<-f.done
+
+	return f.domainAvailabilityResp, f.resp, f.err
+}
+
+// Cancel cancels the request. Wait returns the context error if the request hasn't completed yet.
+func (f *Future) Cancel() {
+	f.cancel()
+}
+
+// WaitAll blocks until all futures are ready or the context is done.
+// It returns the context error in the latter case.
+func WaitAll(ctx context.Context, futures ...*Future) error {
+	for _, f := range futures {
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// WaitAny blocks until any of the futures is ready or the context is done and returns the ready future.
+// It returns the context error in the latter case and nil future if the list is empty.
+func WaitAny(ctx context.Context, futures ...*Future) (*Future, error) {
+	if len(futures) == 0 {
+		return nil, nil
+	}
+
+	cases := make([]reflect.SelectCase, 0, len(futures)+1)
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())})
+
+	for _, f := range futures {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(f.done)})
+	}
+
+	chosen, _, _ := reflect.Select(cases)
+	if chosen == 0 {
+		return nil, ctx.Err()
+	}
+
+	return futures[chosen-1], nil
+}
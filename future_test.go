@@ -0,0 +1,65 @@
+package domainavailability
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestGetAsync tests the futures and the wait helpers.
+func TestGetAsync(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"UNAVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("domainName") == "slow.com" {
+			<-req.Context().Done()
+
+			return
+		}
+
+		_, _ = w.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	apiURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api := NewClient(apiKey, ClientParams{HTTPClient: server.Client(), DomainAvailabilityBaseURL: apiURL})
+
+	ctx := context.Background()
+
+	fast := api.GetAsync(ctx, "whoisxmlapi.com")
+	slow := api.GetAsync(ctx, "slow.com")
+
+	ready, err := WaitAny(ctx, slow, fast)
+	if err != nil || ready != fast {
+		t.Fatalf("WaitAny() = %v, %v, want the fast future", ready, err)
+	}
+
+	if domainAvailabilityResp, _, err := fast.Wait(); err != nil || domainAvailabilityResp == nil {
+		t.Errorf("Future.Wait() = %v, %v, want response", domainAvailabilityResp, err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	if err = WaitAll(timeoutCtx, fast, slow); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitAll() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	slow.Cancel()
+
+	if _, _, err = slow.Wait(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Future.Wait() error = %v, want %v", err, context.Canceled)
+	}
+
+	if err = WaitAll(ctx, fast, slow); err != nil {
+		t.Errorf("WaitAll() error = %v", err)
+	}
+}
@@ -0,0 +1,937 @@
+// Domain Availability API checks as a gRPC service.
+//
+// Messages mirror DomainAvailabilityResponse and ErrorMessage of the Go client library.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: domainavailability/v1/domain_availability.proto
+
+package domainavailabilityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Mode is the check mode.
+type Mode int32
+
+const (
+	// MODE_UNSPECIFIED leaves the API default, DNS_ONLY.
+	Mode_MODE_UNSPECIFIED   Mode = 0
+	Mode_MODE_DNS_ONLY      Mode = 1
+	Mode_MODE_DNS_AND_WHOIS Mode = 2
+)
+
+// Enum value maps for Mode.
+var (
+	Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_DNS_ONLY",
+		2: "MODE_DNS_AND_WHOIS",
+	}
+	Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED":   0,
+		"MODE_DNS_ONLY":      1,
+		"MODE_DNS_AND_WHOIS": 2,
+	}
+)
+
+func (x Mode) Enum() *Mode {
+	p := new(Mode)
+	*p = x
+	return p
+}
+
+func (x Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_domainavailability_v1_domain_availability_proto_enumTypes[0].Descriptor()
+}
+
+func (Mode) Type() protoreflect.EnumType {
+	return &file_domainavailability_v1_domain_availability_proto_enumTypes[0]
+}
+
+func (x Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Mode.Descriptor instead.
+func (Mode) EnumDescriptor() ([]byte, []int) {
+	return file_domainavailability_v1_domain_availability_proto_rawDescGZIP(), []int{0}
+}
+
+// Credits is the type of credits used.
+type Credits int32
+
+const (
+	// CREDITS_UNSPECIFIED leaves the API default, WHOIS.
+	Credits_CREDITS_UNSPECIFIED Credits = 0
+	Credits_CREDITS_DA          Credits = 1
+	Credits_CREDITS_WHOIS       Credits = 2
+)
+
+// Enum value maps for Credits.
+var (
+	Credits_name = map[int32]string{
+		0: "CREDITS_UNSPECIFIED",
+		1: "CREDITS_DA",
+		2: "CREDITS_WHOIS",
+	}
+	Credits_value = map[string]int32{
+		"CREDITS_UNSPECIFIED": 0,
+		"CREDITS_DA":          1,
+		"CREDITS_WHOIS":       2,
+	}
+)
+
+func (x Credits) Enum() *Credits {
+	p := new(Credits)
+	*p = x
+	return p
+}
+
+func (x Credits) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Credits) Descriptor() protoreflect.EnumDescriptor {
+	return file_domainavailability_v1_domain_availability_proto_enumTypes[1].Descriptor()
+}
+
+func (Credits) Type() protoreflect.EnumType {
+	return &file_domainavailability_v1_domain_availability_proto_enumTypes[1]
+}
+
+func (x Credits) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Credits.Descriptor instead.
+func (Credits) EnumDescriptor() ([]byte, []int) {
+	return file_domainavailability_v1_domain_availability_proto_rawDescGZIP(), []int{1}
+}
+
+// Availability is the registration state of the domain name.
+type Availability int32
+
+const (
+	Availability_AVAILABILITY_UNSPECIFIED Availability = 0
+	Availability_AVAILABILITY_AVAILABLE   Availability = 1
+	Availability_AVAILABILITY_UNAVAILABLE Availability = 2
+)
+
+// Enum value maps for Availability.
+var (
+	Availability_name = map[int32]string{
+		0: "AVAILABILITY_UNSPECIFIED",
+		1: "AVAILABILITY_AVAILABLE",
+		2: "AVAILABILITY_UNAVAILABLE",
+	}
+	Availability_value = map[string]int32{
+		"AVAILABILITY_UNSPECIFIED": 0,
+		"AVAILABILITY_AVAILABLE":   1,
+		"AVAILABILITY_UNAVAILABLE": 2,
+	}
+)
+
+func (x Availability) Enum() *Availability {
+	p := new(Availability)
+	*p = x
+	return p
+}
+
+func (x Availability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Availability) Descriptor() protoreflect.EnumDescriptor {
+	return file_domainavailability_v1_domain_availability_proto_enumTypes[2].Descriptor()
+}
+
+func (Availability) Type() protoreflect.EnumType {
+	return &file_domainavailability_v1_domain_availability_proto_enumTypes[2]
+}
+
+func (x Availability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Availability.Descriptor instead.
+func (Availability) EnumDescriptor() ([]byte, []int) {
+	return file_domainavailability_v1_domain_availability_proto_rawDescGZIP(), []int{2}
+}
+
+type CheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DomainName string  `protobuf:"bytes,1,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	Mode       Mode    `protobuf:"varint,2,opt,name=mode,proto3,enum=domainavailability.v1.Mode" json:"mode,omitempty"`
+	Credits    Credits `protobuf:"varint,3,opt,name=credits,proto3,enum=domainavailability.v1.Credits" json:"credits,omitempty"`
+}
+
+func (x *CheckRequest) Reset() {
+	*x = CheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRequest) ProtoMessage() {}
+
+func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
+func (*CheckRequest) Descriptor() ([]byte, []int) {
+	return file_domainavailability_v1_domain_availability_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CheckRequest) GetDomainName() string {
+	if x != nil {
+		return x.DomainName
+	}
+	return ""
+}
+
+func (x *CheckRequest) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *CheckRequest) GetCredits() Credits {
+	if x != nil {
+		return x.Credits
+	}
+	return Credits_CREDITS_UNSPECIFIED
+}
+
+// DomainAvailabilityResponse mirrors the Go DomainAvailabilityResponse.
+type DomainAvailabilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DomainName         string       `protobuf:"bytes,1,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	DomainAvailability Availability `protobuf:"varint,2,opt,name=domain_availability,json=domainAvailability,proto3,enum=domainavailability.v1.Availability" json:"domain_availability,omitempty"`
+}
+
+func (x *DomainAvailabilityResponse) Reset() {
+	*x = DomainAvailabilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DomainAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainAvailabilityResponse) ProtoMessage() {}
+
+func (x *DomainAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*DomainAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_domainavailability_v1_domain_availability_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DomainAvailabilityResponse) GetDomainName() string {
+	if x != nil {
+		return x.DomainName
+	}
+	return ""
+}
+
+func (x *DomainAvailabilityResponse) GetDomainAvailability() Availability {
+	if x != nil {
+		return x.DomainAvailability
+	}
+	return Availability_AVAILABILITY_UNSPECIFIED
+}
+
+// ErrorMessage mirrors the Go ErrorMessage.
+type ErrorMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode string `protobuf:"bytes,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Msg       string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *ErrorMessage) Reset() {
+	*x = ErrorMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorMessage) ProtoMessage() {}
+
+func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorMessage.ProtoReflect.Descriptor instead.
+func (*ErrorMessage) Descriptor() ([]byte, []int) {
+	return file_domainavailability_v1_domain_availability_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ErrorMessage) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *ErrorMessage) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// CheckResponse carries either the response or the error of a single check.
+type CheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DomainName string `protobuf:"bytes,1,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	// Types that are assignable to Result:
+	//	*CheckResponse_Response
+	//	*CheckResponse_Error
+	Result isCheckResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *CheckResponse) Reset() {
+	*x = CheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResponse) ProtoMessage() {}
+
+func (x *CheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
+func (*CheckResponse) Descriptor() ([]byte, []int) {
+	return file_domainavailability_v1_domain_availability_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckResponse) GetDomainName() string {
+	if x != nil {
+		return x.DomainName
+	}
+	return ""
+}
+
+func (m *CheckResponse) GetResult() isCheckResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *CheckResponse) GetResponse() *DomainAvailabilityResponse {
+	if x, ok := x.GetResult().(*CheckResponse_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CheckResponse) GetError() *ErrorMessage {
+	if x, ok := x.GetResult().(*CheckResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCheckResponse_Result interface {
+	isCheckResponse_Result()
+}
+
+type CheckResponse_Response struct {
+	Response *DomainAvailabilityResponse `protobuf:"bytes,2,opt,name=response,proto3,oneof"`
+}
+
+type CheckResponse_Error struct {
+	Error *ErrorMessage `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CheckResponse_Response) isCheckResponse_Result() {}
+
+func (*CheckResponse_Error) isCheckResponse_Result() {}
+
+type BulkCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DomainNames []string `protobuf:"bytes,1,rep,name=domain_names,json=domainNames,proto3" json:"domain_names,omitempty"`
+	Mode        Mode     `protobuf:"varint,2,opt,name=mode,proto3,enum=domainavailability.v1.Mode" json:"mode,omitempty"`
+	Credits     Credits  `protobuf:"varint,3,opt,name=credits,proto3,enum=domainavailability.v1.Credits" json:"credits,omitempty"`
+}
+
+func (x *BulkCheckRequest) Reset() {
+	*x = BulkCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCheckRequest) ProtoMessage() {}
+
+func (x *BulkCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCheckRequest.ProtoReflect.Descriptor instead.
+func (*BulkCheckRequest) Descriptor() ([]byte, []int) {
+	return file_domainavailability_v1_domain_availability_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BulkCheckRequest) GetDomainNames() []string {
+	if x != nil {
+		return x.DomainNames
+	}
+	return nil
+}
+
+func (x *BulkCheckRequest) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *BulkCheckRequest) GetCredits() Credits {
+	if x != nil {
+		return x.Credits
+	}
+	return Credits_CREDITS_UNSPECIFIED
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DomainNames []string `protobuf:"bytes,1,rep,name=domain_names,json=domainNames,proto3" json:"domain_names,omitempty"`
+	Mode        Mode     `protobuf:"varint,2,opt,name=mode,proto3,enum=domainavailability.v1.Mode" json:"mode,omitempty"`
+	Credits     Credits  `protobuf:"varint,3,opt,name=credits,proto3,enum=domainavailability.v1.Credits" json:"credits,omitempty"`
+	// interval_seconds is the re-check interval, the server default is used if it's zero.
+	IntervalSeconds uint32 `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_domainavailability_v1_domain_availability_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchRequest) GetDomainNames() []string {
+	if x != nil {
+		return x.DomainNames
+	}
+	return nil
+}
+
+func (x *WatchRequest) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *WatchRequest) GetCredits() Credits {
+	if x != nil {
+		return x.Credits
+	}
+	return Credits_CREDITS_UNSPECIFIED
+}
+
+func (x *WatchRequest) GetIntervalSeconds() uint32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+// WatchEvent mirrors the Go watcher.Event.
+type WatchEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DomainName    string       `protobuf:"bytes,1,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	WasAvailable  Availability `protobuf:"varint,2,opt,name=was_available,json=wasAvailable,proto3,enum=domainavailability.v1.Availability" json:"was_available,omitempty"`
+	IsAvailable   Availability `protobuf:"varint,3,opt,name=is_available,json=isAvailable,proto3,enum=domainavailability.v1.Availability" json:"is_available,omitempty"`
+	CheckedAtUnix int64        `protobuf:"varint,4,opt,name=checked_at_unix,json=checkedAtUnix,proto3" json:"checked_at_unix,omitempty"`
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_domainavailability_v1_domain_availability_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_domainavailability_v1_domain_availability_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchEvent) GetDomainName() string {
+	if x != nil {
+		return x.DomainName
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetWasAvailable() Availability {
+	if x != nil {
+		return x.WasAvailable
+	}
+	return Availability_AVAILABILITY_UNSPECIFIED
+}
+
+func (x *WatchEvent) GetIsAvailable() Availability {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return Availability_AVAILABILITY_UNSPECIFIED
+}
+
+func (x *WatchEvent) GetCheckedAtUnix() int64 {
+	if x != nil {
+		return x.CheckedAtUnix
+	}
+	return 0
+}
+
+var File_domainavailability_v1_domain_availability_proto protoreflect.FileDescriptor
+
+var file_domainavailability_v1_domain_availability_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x15, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x22, 0x9a, 0x01, 0x0a, 0x0c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x1a, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x54, 0x0a, 0x13, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x12, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x3f, 0x0a, 0x0c, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xc8, 0x01, 0x0a,
+	0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x4f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x42, 0x75, 0x6c, 0x6b,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x38, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2f,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x38, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x77, 0x61, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x0c, 0x77, 0x61, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x46,
+	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x2a, 0x47,
+	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x4e, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12,
+	0x16, 0x0a, 0x12, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x4e, 0x53, 0x5f, 0x41, 0x4e, 0x44, 0x5f,
+	0x57, 0x48, 0x4f, 0x49, 0x53, 0x10, 0x02, 0x2a, 0x45, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43,
+	0x52, 0x45, 0x44, 0x49, 0x54, 0x53, 0x5f, 0x44, 0x41, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x43,
+	0x52, 0x45, 0x44, 0x49, 0x54, 0x53, 0x5f, 0x57, 0x48, 0x4f, 0x49, 0x53, 0x10, 0x02, 0x2a, 0x66,
+	0x0a, 0x0c, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c,
+	0x0a, 0x18, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x56, 0x41,
+	0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x56, 0x41, 0x49,
+	0x4c, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x32, 0xa0, 0x02, 0x0a, 0x19, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x23, 0x2e,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x42, 0x75, 0x6c, 0x6b,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x27, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x6c, 0x6b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x23, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x68, 0x6f, 0x69, 0x73, 0x2d, 0x61, 0x70,
+	0x69, 0x2d, 0x6c, 0x6c, 0x63, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2d, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2d, 0x67, 0x6f, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_domainavailability_v1_domain_availability_proto_rawDescOnce sync.Once
+	file_domainavailability_v1_domain_availability_proto_rawDescData = file_domainavailability_v1_domain_availability_proto_rawDesc
+)
+
+func file_domainavailability_v1_domain_availability_proto_rawDescGZIP() []byte {
+	file_domainavailability_v1_domain_availability_proto_rawDescOnce.Do(func() {
+		file_domainavailability_v1_domain_availability_proto_rawDescData = protoimpl.X.CompressGZIP(file_domainavailability_v1_domain_availability_proto_rawDescData)
+	})
+	return file_domainavailability_v1_domain_availability_proto_rawDescData
+}
+
+var file_domainavailability_v1_domain_availability_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_domainavailability_v1_domain_availability_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_domainavailability_v1_domain_availability_proto_goTypes = []any{
+	(Mode)(0),                          // 0: domainavailability.v1.Mode
+	(Credits)(0),                       // 1: domainavailability.v1.Credits
+	(Availability)(0),                  // 2: domainavailability.v1.Availability
+	(*CheckRequest)(nil),               // 3: domainavailability.v1.CheckRequest
+	(*DomainAvailabilityResponse)(nil), // 4: domainavailability.v1.DomainAvailabilityResponse
+	(*ErrorMessage)(nil),               // 5: domainavailability.v1.ErrorMessage
+	(*CheckResponse)(nil),              // 6: domainavailability.v1.CheckResponse
+	(*BulkCheckRequest)(nil),           // 7: domainavailability.v1.BulkCheckRequest
+	(*WatchRequest)(nil),               // 8: domainavailability.v1.WatchRequest
+	(*WatchEvent)(nil),                 // 9: domainavailability.v1.WatchEvent
+}
+var file_domainavailability_v1_domain_availability_proto_depIdxs = []int32{
+	0,  // 0: domainavailability.v1.CheckRequest.mode:type_name -> domainavailability.v1.Mode
+	1,  // 1: domainavailability.v1.CheckRequest.credits:type_name -> domainavailability.v1.Credits
+	2,  // 2: domainavailability.v1.DomainAvailabilityResponse.domain_availability:type_name -> domainavailability.v1.Availability
+	4,  // 3: domainavailability.v1.CheckResponse.response:type_name -> domainavailability.v1.DomainAvailabilityResponse
+	5,  // 4: domainavailability.v1.CheckResponse.error:type_name -> domainavailability.v1.ErrorMessage
+	0,  // 5: domainavailability.v1.BulkCheckRequest.mode:type_name -> domainavailability.v1.Mode
+	1,  // 6: domainavailability.v1.BulkCheckRequest.credits:type_name -> domainavailability.v1.Credits
+	0,  // 7: domainavailability.v1.WatchRequest.mode:type_name -> domainavailability.v1.Mode
+	1,  // 8: domainavailability.v1.WatchRequest.credits:type_name -> domainavailability.v1.Credits
+	2,  // 9: domainavailability.v1.WatchEvent.was_available:type_name -> domainavailability.v1.Availability
+	2,  // 10: domainavailability.v1.WatchEvent.is_available:type_name -> domainavailability.v1.Availability
+	3,  // 11: domainavailability.v1.DomainAvailabilityService.Check:input_type -> domainavailability.v1.CheckRequest
+	7,  // 12: domainavailability.v1.DomainAvailabilityService.BulkCheck:input_type -> domainavailability.v1.BulkCheckRequest
+	8,  // 13: domainavailability.v1.DomainAvailabilityService.Watch:input_type -> domainavailability.v1.WatchRequest
+	6,  // 14: domainavailability.v1.DomainAvailabilityService.Check:output_type -> domainavailability.v1.CheckResponse
+	6,  // 15: domainavailability.v1.DomainAvailabilityService.BulkCheck:output_type -> domainavailability.v1.CheckResponse
+	9,  // 16: domainavailability.v1.DomainAvailabilityService.Watch:output_type -> domainavailability.v1.WatchEvent
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_domainavailability_v1_domain_availability_proto_init() }
+func file_domainavailability_v1_domain_availability_proto_init() {
+	if File_domainavailability_v1_domain_availability_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_domainavailability_v1_domain_availability_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domainavailability_v1_domain_availability_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*DomainAvailabilityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domainavailability_v1_domain_availability_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ErrorMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domainavailability_v1_domain_availability_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domainavailability_v1_domain_availability_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*BulkCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domainavailability_v1_domain_availability_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domainavailability_v1_domain_availability_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_domainavailability_v1_domain_availability_proto_msgTypes[3].OneofWrappers = []any{
+		(*CheckResponse_Response)(nil),
+		(*CheckResponse_Error)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_domainavailability_v1_domain_availability_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_domainavailability_v1_domain_availability_proto_goTypes,
+		DependencyIndexes: file_domainavailability_v1_domain_availability_proto_depIdxs,
+		EnumInfos:         file_domainavailability_v1_domain_availability_proto_enumTypes,
+		MessageInfos:      file_domainavailability_v1_domain_availability_proto_msgTypes,
+	}.Build()
+	File_domainavailability_v1_domain_availability_proto = out.File
+	file_domainavailability_v1_domain_availability_proto_rawDesc = nil
+	file_domainavailability_v1_domain_availability_proto_goTypes = nil
+	file_domainavailability_v1_domain_availability_proto_depIdxs = nil
+}
@@ -0,0 +1,219 @@
+// Domain Availability API checks as a gRPC service.
+//
+// Messages mirror DomainAvailabilityResponse and ErrorMessage of the Go client library.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: domainavailability/v1/domain_availability.proto
+
+package domainavailabilityv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DomainAvailabilityService_Check_FullMethodName     = "/domainavailability.v1.DomainAvailabilityService/Check"
+	DomainAvailabilityService_BulkCheck_FullMethodName = "/domainavailability.v1.DomainAvailabilityService/BulkCheck"
+	DomainAvailabilityService_Watch_FullMethodName     = "/domainavailability.v1.DomainAvailabilityService/Watch"
+)
+
+// DomainAvailabilityServiceClient is the client API for DomainAvailabilityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DomainAvailabilityService checks the registration state of domain names.
+type DomainAvailabilityServiceClient interface {
+	// Check returns the registration state of a single domain name.
+	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	// BulkCheck streams the results of checking the domain names as soon as they are ready.
+	BulkCheck(ctx context.Context, in *BulkCheckRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CheckResponse], error)
+	// Watch re-checks the domain names periodically and streams changes of their registration state.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error)
+}
+
+type domainAvailabilityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDomainAvailabilityServiceClient(cc grpc.ClientConnInterface) DomainAvailabilityServiceClient {
+	return &domainAvailabilityServiceClient{cc}
+}
+
+func (c *domainAvailabilityServiceClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, DomainAvailabilityService_Check_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainAvailabilityServiceClient) BulkCheck(ctx context.Context, in *BulkCheckRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CheckResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DomainAvailabilityService_ServiceDesc.Streams[0], DomainAvailabilityService_BulkCheck_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BulkCheckRequest, CheckResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DomainAvailabilityService_BulkCheckClient = grpc.ServerStreamingClient[CheckResponse]
+
+func (c *domainAvailabilityServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DomainAvailabilityService_ServiceDesc.Streams[1], DomainAvailabilityService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRequest, WatchEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DomainAvailabilityService_WatchClient = grpc.ServerStreamingClient[WatchEvent]
+
+// DomainAvailabilityServiceServer is the server API for DomainAvailabilityService service.
+// All implementations must embed UnimplementedDomainAvailabilityServiceServer
+// for forward compatibility.
+//
+// DomainAvailabilityService checks the registration state of domain names.
+type DomainAvailabilityServiceServer interface {
+	// Check returns the registration state of a single domain name.
+	Check(context.Context, *CheckRequest) (*CheckResponse, error)
+	// BulkCheck streams the results of checking the domain names as soon as they are ready.
+	BulkCheck(*BulkCheckRequest, grpc.ServerStreamingServer[CheckResponse]) error
+	// Watch re-checks the domain names periodically and streams changes of their registration state.
+	Watch(*WatchRequest, grpc.ServerStreamingServer[WatchEvent]) error
+	mustEmbedUnimplementedDomainAvailabilityServiceServer()
+}
+
+// UnimplementedDomainAvailabilityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDomainAvailabilityServiceServer struct{}
+
+func (UnimplementedDomainAvailabilityServiceServer) Check(context.Context, *CheckRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
+}
+func (UnimplementedDomainAvailabilityServiceServer) BulkCheck(*BulkCheckRequest, grpc.ServerStreamingServer[CheckResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method BulkCheck not implemented")
+}
+func (UnimplementedDomainAvailabilityServiceServer) Watch(*WatchRequest, grpc.ServerStreamingServer[WatchEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedDomainAvailabilityServiceServer) mustEmbedUnimplementedDomainAvailabilityServiceServer() {
+}
+func (UnimplementedDomainAvailabilityServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDomainAvailabilityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DomainAvailabilityServiceServer will
+// result in compilation errors.
+type UnsafeDomainAvailabilityServiceServer interface {
+	mustEmbedUnimplementedDomainAvailabilityServiceServer()
+}
+
+func RegisterDomainAvailabilityServiceServer(s grpc.ServiceRegistrar, srv DomainAvailabilityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDomainAvailabilityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DomainAvailabilityService_ServiceDesc, srv)
+}
+
+func _DomainAvailabilityService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainAvailabilityServiceServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainAvailabilityService_Check_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainAvailabilityServiceServer).Check(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainAvailabilityService_BulkCheck_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BulkCheckRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DomainAvailabilityServiceServer).BulkCheck(m, &grpc.GenericServerStream[BulkCheckRequest, CheckResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DomainAvailabilityService_BulkCheckServer = grpc.ServerStreamingServer[CheckResponse]
+
+func _DomainAvailabilityService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DomainAvailabilityServiceServer).Watch(m, &grpc.GenericServerStream[WatchRequest, WatchEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DomainAvailabilityService_WatchServer = grpc.ServerStreamingServer[WatchEvent]
+
+// DomainAvailabilityService_ServiceDesc is the grpc.ServiceDesc for DomainAvailabilityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DomainAvailabilityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "domainavailability.v1.DomainAvailabilityService",
+	HandlerType: (*DomainAvailabilityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Check",
+			Handler:    _DomainAvailabilityService_Check_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BulkCheck",
+			Handler:       _DomainAvailabilityService_BulkCheck_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _DomainAvailabilityService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "domainavailability/v1/domain_availability.proto",
+}
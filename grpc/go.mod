@@ -0,0 +1,18 @@
+module github.com/whois-api-llc/domain-availability-go/grpc
+
+go 1.21
+
+require (
+	github.com/whois-api-llc/domain-availability-go v0.0.0-00010101000000-000000000000
+	google.golang.org/grpc v1.64.1
+	google.golang.org/protobuf v1.34.2
+)
+
+require (
+	golang.org/x/net v0.26.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	golang.org/x/text v0.16.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237 // indirect
+)
+
+replace github.com/whois-api-llc/domain-availability-go => ../
@@ -0,0 +1,362 @@
+// Package grpcserver implements the DomainAvailabilityService gRPC service
+// backed by the Domain Availability API client.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+	pb "github.com/whois-api-llc/domain-availability-go/grpc/domainavailabilityv1"
+	"github.com/whois-api-llc/domain-availability-go/watcher"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMaxBulk          = 100
+	defaultBulkWorkers      = 8
+	defaultWatchInterval    = 10 * time.Minute
+	defaultMinWatchInterval = time.Minute
+)
+
+// Params is used to create Server. None of parameters are mandatory.
+type Params struct {
+	// MaxBulk is the maximum number of domain names in a BulkCheck or Watch request
+	// If it's zero then 100 is used
+	MaxBulk int
+
+	// BulkWorkers is the number of concurrent API requests for a BulkCheck request
+	// If it's zero then 8 is used
+	BulkWorkers int
+
+	// WatchInterval is the re-check interval of Watch requests without their own interval
+	// If it's zero then 10 minutes is used
+	WatchInterval time.Duration
+
+	// MinWatchInterval is the lowest re-check interval a Watch request can ask for
+	// If it's zero then 1 minute is used
+	MinWatchInterval time.Duration
+}
+
+// Server is the DomainAvailabilityService gRPC server.
+type Server struct {
+	pb.UnimplementedDomainAvailabilityServiceServer
+
+	service domainavailability.DomainAvailabilityService
+	params  Params
+}
+
+var _ pb.DomainAvailabilityServiceServer = &Server{}
+
+// New creates Server backed by the service.
+func New(service domainavailability.DomainAvailabilityService, params Params) *Server {
+	if params.MaxBulk <= 0 {
+		params.MaxBulk = defaultMaxBulk
+	}
+
+	if params.BulkWorkers <= 0 {
+		params.BulkWorkers = defaultBulkWorkers
+	}
+
+	if params.WatchInterval <= 0 {
+		params.WatchInterval = defaultWatchInterval
+	}
+
+	if params.MinWatchInterval <= 0 {
+		params.MinWatchInterval = defaultMinWatchInterval
+	}
+
+	return &Server{service: service, params: params}
+}
+
+// Check returns the registration state of a single domain name.
+// API errors are returned in the response, other errors as the gRPC status.
+func (s *Server) Check(ctx context.Context, req *pb.CheckRequest) (*pb.CheckResponse, error) {
+	if req.GetDomainName() == "" {
+		return nil, status.Error(codes.InvalidArgument, `"domain_name" can not be empty`)
+	}
+
+	opts, err := options(req.GetMode(), req.GetCredits())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, _, err := s.service.Get(ctx, req.GetDomainName(), opts...)
+
+	var apiErr *domainavailability.ErrorMessage
+	if err != nil && !errors.As(err, &apiErr) {
+		return nil, toStatus(err)
+	}
+
+	return toCheckResponse(req.GetDomainName(), resp, err), nil
+}
+
+// BulkCheck streams the results of checking the domain names in the order they are ready.
+// Errors of single checks are returned in their responses.
+func (s *Server) BulkCheck(req *pb.BulkCheckRequest, stream pb.DomainAvailabilityService_BulkCheckServer) error {
+	domainNames := req.GetDomainNames()
+	if err := s.checkDomainNames(domainNames); err != nil {
+		return err
+	}
+
+	opts, err := options(req.GetMode(), req.GetCredits())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	indexes := make(chan int)
+	results := make(chan *pb.CheckResponse)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < s.params.BulkWorkers && w < len(domainNames); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				resp, _, err := s.service.Get(ctx, domainNames[i], opts...)
+
+				select {
+				case results <- toCheckResponse(domainNames[i], resp, err):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(indexes)
+
+		for i := range domainNames {
+			select {
+			case indexes <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for resp := range results {
+		if err := stream.Send(resp); err != nil {
+			cancel()
+
+			for range results {
+			}
+
+			return err
+		}
+	}
+
+	return toStatus(ctx.Err())
+}
+
+// Watch re-checks the domain names periodically and streams changes of their registration state
+// until the client cancels the call. The first check of a domain name only records its state.
+// Events are sent by a notifier, so a slow client blocks the checks instead of losing events.
+func (s *Server) Watch(req *pb.WatchRequest, stream pb.DomainAvailabilityService_WatchServer) error {
+	domainNames := req.GetDomainNames()
+	if err := s.checkDomainNames(domainNames); err != nil {
+		return err
+	}
+
+	opts, err := options(req.GetMode(), req.GetCredits())
+	if err != nil {
+		return err
+	}
+
+	interval := s.params.WatchInterval
+	if req.GetIntervalSeconds() > 0 {
+		interval = time.Duration(req.GetIntervalSeconds()) * time.Second
+	}
+
+	if interval < s.params.MinWatchInterval {
+		interval = s.params.MinWatchInterval
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		sendErr error
+	)
+
+	// stream.Send must not be called concurrently, and it blocks under flow control
+	// until the client reads or the call is canceled.
+	notifier := watcher.NotifierFunc(func(ctx context.Context, event watcher.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if sendErr != nil {
+			return sendErr
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		sendErr = stream.Send(&pb.WatchEvent{
+			DomainName:    event.DomainName,
+			WasAvailable:  toAvailability(event.Previous),
+			IsAvailable:   toAvailability(event.Current),
+			CheckedAtUnix: event.CheckedAt.Unix(),
+		})
+		if sendErr != nil {
+			cancel()
+		}
+
+		return sendErr
+	})
+
+	w := watcher.New(s.service, watcher.Params{
+		Interval:  interval,
+		Notifiers: []watcher.Notifier{notifier},
+	})
+	for _, domainName := range domainNames {
+		w.Add(domainName, 0, opts...)
+	}
+
+	_ = w.Run(ctx)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if sendErr != nil {
+		return sendErr
+	}
+
+	return toStatus(stream.Context().Err())
+}
+
+// checkDomainNames validates the domain names of BulkCheck and Watch requests.
+func (s *Server) checkDomainNames(domainNames []string) error {
+	if len(domainNames) == 0 {
+		return status.Error(codes.InvalidArgument, `"domain_names" can not be empty`)
+	}
+
+	if len(domainNames) > s.params.MaxBulk {
+		return status.Errorf(codes.InvalidArgument, "too many domain names: %d, maximum is %d",
+			len(domainNames), s.params.MaxBulk)
+	}
+
+	for _, domainName := range domainNames {
+		if domainName == "" {
+			return status.Error(codes.InvalidArgument, `"domain_names" can not contain empty names`)
+		}
+	}
+
+	return nil
+}
+
+// options converts the mode and credits of the request to the API options.
+func options(mode pb.Mode, credits pb.Credits) ([]domainavailability.Option, error) {
+	var opts []domainavailability.Option
+
+	switch mode {
+	case pb.Mode_MODE_UNSPECIFIED:
+	case pb.Mode_MODE_DNS_ONLY:
+		opts = append(opts, domainavailability.OptionMode("DNS_ONLY"))
+	case pb.Mode_MODE_DNS_AND_WHOIS:
+		opts = append(opts, domainavailability.OptionMode("DNS_AND_WHOIS"))
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown mode %d", mode)
+	}
+
+	switch credits {
+	case pb.Credits_CREDITS_UNSPECIFIED:
+	case pb.Credits_CREDITS_DA:
+		opts = append(opts, domainavailability.OptionCredits("DA"))
+	case pb.Credits_CREDITS_WHOIS:
+		opts = append(opts, domainavailability.OptionCredits("WHOIS"))
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown credits %d", credits)
+	}
+
+	return opts, nil
+}
+
+// toCheckResponse converts the result of a single check to CheckResponse.
+func toCheckResponse(
+	domainName string,
+	resp *domainavailability.DomainAvailabilityResponse,
+	err error,
+) *pb.CheckResponse {
+	if err != nil {
+		return &pb.CheckResponse{
+			DomainName: domainName,
+			Result:     &pb.CheckResponse_Error{Error: toErrorMessage(err)},
+		}
+	}
+
+	return &pb.CheckResponse{
+		DomainName: domainName,
+		Result: &pb.CheckResponse_Response{Response: &pb.DomainAvailabilityResponse{
+			DomainName:         resp.DomainName,
+			DomainAvailability: toAvailability(resp.Availability),
+		}},
+	}
+}
+
+// toErrorMessage converts the service error to ErrorMessage.
+func toErrorMessage(err error) *pb.ErrorMessage {
+	var apiErr *domainavailability.ErrorMessage
+	if errors.As(err, &apiErr) {
+		return &pb.ErrorMessage{ErrorCode: apiErr.Code, Msg: apiErr.Message}
+	}
+
+	var argErr *domainavailability.ArgError
+	if errors.As(err, &argErr) {
+		return &pb.ErrorMessage{ErrorCode: "BAD_REQUEST", Msg: argErr.Error()}
+	}
+
+	return &pb.ErrorMessage{ErrorCode: "UPSTREAM_ERROR", Msg: err.Error()}
+}
+
+// toAvailability converts the registration state to its protobuf representation.
+func toAvailability(a domainavailability.Availability) pb.Availability {
+	switch {
+	case a.IsAvailable():
+		return pb.Availability_AVAILABILITY_AVAILABLE
+	case a.IsUnavailable():
+		return pb.Availability_AVAILABILITY_UNAVAILABLE
+	default:
+		return pb.Availability_AVAILABILITY_UNSPECIFIED
+	}
+}
+
+// toStatus converts the service error to the gRPC status error.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	var argErr *domainavailability.ArgError
+	if errors.As(err, &argErr) {
+		return status.Error(codes.InvalidArgument, argErr.Error())
+	}
+
+	var budgetErr *domainavailability.BudgetError
+	if errors.As(err, &budgetErr) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return status.Error(codes.Unavailable, err.Error())
+}
@@ -0,0 +1,290 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+	pb "github.com/whois-api-llc/domain-availability-go/grpc/domainavailabilityv1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeService answers AVAILABLE for domain names starting with "free", API errors for "error.com"
+// and flips the state of domain names starting with "flip" on every call until it's frozen.
+type fakeService struct {
+	mu     sync.Mutex
+	calls  map[string]int
+	opts   []string
+	frozen bool
+}
+
+// Get returns the fake response.
+func (s *fakeService) Get(
+	_ context.Context,
+	domainName string,
+	opts ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.calls == nil {
+		s.calls = make(map[string]int)
+	}
+
+	if !s.frozen {
+		s.calls[domainName]++
+	}
+
+	q := make(map[string][]string)
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	if mode := q["mode"]; len(mode) > 0 {
+		s.opts = append(s.opts, mode[0])
+	}
+
+	switch {
+	case domainName == "error.com":
+		return nil, nil, &domainavailability.ErrorMessage{Code: "WHOIS_01", Message: "Test error message."}
+	case strings.HasPrefix(domainName, "flip") && s.calls[domainName]%2 == 0,
+		strings.HasPrefix(domainName, "free"):
+		return &domainavailability.DomainAvailabilityResponse{
+			DomainName:   domainName,
+			Availability: domainavailability.Available,
+		}, nil, nil
+	default:
+		return &domainavailability.DomainAvailabilityResponse{
+			DomainName:   domainName,
+			Availability: domainavailability.Unavailable,
+		}, nil, nil
+	}
+}
+
+// GetRaw is not used by the server.
+func (s *fakeService) GetRaw(context.Context, string, ...domainavailability.Option) (*domainavailability.Response, error) {
+	return nil, nil
+}
+
+// testParams are the server parameters used by the tests.
+var testParams = Params{
+	MaxBulk:          3,
+	WatchInterval:    10 * time.Millisecond,
+	MinWatchInterval: 10 * time.Millisecond,
+}
+
+// newTestClient starts the server on bufconn and returns the generated client connected to it.
+func newTestClient(
+	t *testing.T,
+	service domainavailability.DomainAvailabilityService,
+	params Params,
+) pb.DomainAvailabilityServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterDomainAvailabilityServiceServer(grpcServer, New(service, params))
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		grpcServer.Stop()
+	})
+
+	return pb.NewDomainAvailabilityServiceClient(conn)
+}
+
+// TestCheck tests the responses, API errors and invalid requests of Check.
+func TestCheck(t *testing.T) {
+	service := &fakeService{}
+	client := newTestClient(t, service, testParams)
+	ctx := context.Background()
+
+	resp, err := client.Check(ctx, &pb.CheckRequest{DomainName: "free.com", Mode: pb.Mode_MODE_DNS_AND_WHOIS})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := resp.GetResponse().GetDomainAvailability(); got != pb.Availability_AVAILABILITY_AVAILABLE {
+		t.Errorf("Check() = %s, want AVAILABILITY_AVAILABLE", got)
+	}
+
+	if len(service.opts) != 1 || service.opts[0] != "DNS_AND_WHOIS" {
+		t.Errorf("mode options = %v, want DNS_AND_WHOIS", service.opts)
+	}
+
+	resp, err = client.Check(ctx, &pb.CheckRequest{DomainName: "error.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := resp.GetError(); got.GetErrorCode() != "WHOIS_01" || got.GetMsg() != "Test error message." {
+		t.Errorf("Check() error = %v, want WHOIS_01", got)
+	}
+
+	tests := []struct {
+		name string
+		req  *pb.CheckRequest
+	}{
+		{name: "empty domain name", req: &pb.CheckRequest{}},
+		{name: "unknown mode", req: &pb.CheckRequest{DomainName: "free.com", Mode: 42}},
+		{name: "unknown credits", req: &pb.CheckRequest{DomainName: "free.com", Credits: 42}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.Check(ctx, tt.req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Check() error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+// TestBulkCheck tests BulkCheck streams a result for every domain name and enforces the bulk limit.
+func TestBulkCheck(t *testing.T) {
+	client := newTestClient(t, &fakeService{}, testParams)
+	ctx := context.Background()
+
+	stream, err := client.BulkCheck(ctx, &pb.BulkCheckRequest{DomainNames: []string{"free.com", "taken.com", "error.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result := resp.GetResponse().GetDomainAvailability().String()
+		if resp.GetError() != nil {
+			result = resp.GetError().GetErrorCode()
+		}
+
+		got = append(got, resp.GetDomainName()+" "+result)
+	}
+
+	sort.Strings(got)
+
+	want := []string{"error.com WHOIS_01", "free.com AVAILABILITY_AVAILABLE", "taken.com AVAILABILITY_UNAVAILABLE"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("BulkCheck() = %v, want %v", got, want)
+	}
+
+	stream, err = client.BulkCheck(ctx, &pb.BulkCheckRequest{DomainNames: []string{"a.com", "b.com", "c.com", "d.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := stream.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("BulkCheck() above the limit error = %v, want InvalidArgument", err)
+	}
+}
+
+// TestWatch tests Watch streams the changes of the registration state.
+func TestWatch(t *testing.T) {
+	client := newTestClient(t, &fakeService{}, testParams)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.Watch(ctx, &pb.WatchRequest{DomainNames: []string{"flip.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.GetDomainName() != "flip.com" ||
+		event.GetWasAvailable() != pb.Availability_AVAILABILITY_UNAVAILABLE ||
+		event.GetIsAvailable() != pb.Availability_AVAILABILITY_AVAILABLE ||
+		event.GetCheckedAtUnix() == 0 {
+		t.Errorf("Watch() event = %v, want flip.com from UNAVAILABLE to AVAILABLE", event)
+	}
+
+	cancel()
+
+	if _, err := stream.Recv(); status.Code(err) != codes.Canceled {
+		t.Errorf("Watch() after cancel error = %v, want Canceled", err)
+	}
+}
+
+// TestWatchSlowReceiver tests Watch doesn't lose events while the client doesn't read them.
+func TestWatchSlowReceiver(t *testing.T) {
+	service := &fakeService{}
+	client := newTestClient(t, service, Params{
+		MaxBulk:          20,
+		WatchInterval:    time.Millisecond,
+		MinWatchInterval: time.Millisecond,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var domainNames []string
+	for i := 0; i < 20; i++ {
+		domainNames = append(domainNames, "flip"+strconv.Itoa(i)+".com")
+	}
+
+	stream, err := client.Watch(ctx, &pb.WatchRequest{DomainNames: domainNames})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The events fill the flow control window while nothing is read.
+	time.Sleep(time.Second)
+
+	service.mu.Lock()
+	service.frozen = true
+
+	want := 0
+	for _, calls := range service.calls {
+		if calls > 1 {
+			want += calls - 1
+		}
+	}
+	service.mu.Unlock()
+
+	// 64 is the capacity of the watcher events channel which used to drop events.
+	if want <= 64 {
+		t.Fatalf("%d events produced, want more than the watcher buffer", want)
+	}
+
+	for got := 0; got < want; got++ {
+		if _, err := stream.Recv(); err != nil {
+			t.Fatalf("Watch() received %d events of %d, error = %v", got, want, err)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package domainavailability
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Default hedging parameters.
+const (
+	defaultHedgePercentile   = 0.95
+	defaultHedgeInitialDelay = time.Second
+	defaultHedgeMinDelay     = 10 * time.Millisecond
+	defaultHedgeMaxRatio     = 0.1
+	defaultHedgeWindow       = 100
+
+	// hedgeMinSamples is the number of observed latencies required to use the percentile.
+	hedgeMinSamples = 10
+)
+
+// HedgeParams is used to create Hedger. None of parameters are mandatory.
+type HedgeParams struct {
+	// Percentile of the observed latencies after which the hedged request is sent, from 0 to 1
+	// If it's zero then 0.95 is used
+	Percentile float64
+
+	// InitialDelay is used until enough latencies are observed
+	// If it's zero then 1 second is used
+	InitialDelay time.Duration
+
+	// MinDelay is the lower bound of the delay
+	// If it's zero then 10 milliseconds is used
+	MinDelay time.Duration
+
+	// MaxRatio is the maximum share of hedged requests, which caps the extra credit spend
+	// If it's zero then 0.1 is used, 1 lets every request be hedged
+	MaxRatio float64
+
+	// MaxHedges is the maximum total number of hedged requests
+	// If it's zero then the number is not limited
+	MaxHedges int64
+
+	// Window is the number of recent latencies the percentile is computed from
+	// If it's zero then 100 is used
+	Window int
+}
+
+// HedgeStats are the hedging metrics.
+type HedgeStats struct {
+	// Requests is the number of primary requests.
+	Requests int64
+
+	// Hedged is the number of hedged requests sent.
+	Hedged int64
+
+	// HedgeWins is the number of hedged requests which answered first.
+	HedgeWins int64
+
+	// Suppressed is the number of hedged requests not sent because of MaxRatio or MaxHedges.
+	Suppressed int64
+}
+
+// Hedger sends a second identical request if the first one takes longer than the percentile of
+// the recent latencies and takes whichever answers first, cancelling the other.
+// Every hedged request is charged to the budget like the primary one.
+type Hedger struct {
+	params HedgeParams
+
+	mu        sync.Mutex
+	latencies []time.Duration
+	next      int
+	stats     HedgeStats
+}
+
+// NewHedger creates Hedger.
+func NewHedger(params HedgeParams) *Hedger {
+	if params.Percentile <= 0 || params.Percentile > 1 {
+		params.Percentile = defaultHedgePercentile
+	}
+
+	if params.InitialDelay <= 0 {
+		params.InitialDelay = defaultHedgeInitialDelay
+	}
+
+	if params.MinDelay <= 0 {
+		params.MinDelay = defaultHedgeMinDelay
+	}
+
+	if params.MaxRatio <= 0 {
+		params.MaxRatio = defaultHedgeMaxRatio
+	}
+
+	if params.Window <= 0 {
+		params.Window = defaultHedgeWindow
+	}
+
+	return &Hedger{
+		params:    params,
+		latencies: make([]time.Duration, 0, params.Window),
+	}
+}
+
+// Stats returns the hedging metrics.
+func (h *Hedger) Stats() HedgeStats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.stats
+}
+
+// Delay returns the current delay before the hedged request is sent.
+func (h *Hedger) Delay() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.delay()
+}
+
+// delay returns the percentile of the observed latencies. The mutex must be held.
+func (h *Hedger) delay() time.Duration {
+	if len(h.latencies) < hedgeMinSamples {
+		return h.params.InitialDelay
+	}
+
+	sorted := append([]time.Duration(nil), h.latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	d := sorted[int(math.Ceil(h.params.Percentile*float64(len(sorted))))-1]
+	if d < h.params.MinDelay {
+		d = h.params.MinDelay
+	}
+
+	return d
+}
+
+// start counts the primary request and returns the hedge delay.
+func (h *Hedger) start() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.stats.Requests++
+
+	return h.delay()
+}
+
+// allow reports whether a hedged request may be sent and counts it.
+func (h *Hedger) allow() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.params.MaxHedges > 0 && h.stats.Hedged >= h.params.MaxHedges ||
+		float64(h.stats.Hedged+1) > h.params.MaxRatio*float64(h.stats.Requests) {
+		h.stats.Suppressed++
+		return false
+	}
+
+	h.stats.Hedged++
+
+	return true
+}
+
+// observe records the latency of the successful request and whether the hedged request won.
+func (h *Hedger) observe(latency time.Duration, hedgeWon bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if hedgeWon {
+		h.stats.HedgeWins++
+	}
+
+	if len(h.latencies) < h.params.Window {
+		h.latencies = append(h.latencies, latency)
+		return
+	}
+
+	h.latencies[h.next] = latency
+	h.next = (h.next + 1) % h.params.Window
+}
+
+// hedgeResult is the outcome of one of the hedged requests.
+type hedgeResult struct {
+	resp  *Response
+	err   error
+	hedge bool
+}
+
+// succeeded reports whether the request got a 2xx response.
+func (r hedgeResult) succeeded() bool {
+	return r.err == nil && r.resp != nil && r.resp.Response != nil && checkResponse(r.resp.Response) == nil
+}
+
+// do sends the request via send, and its copy with a new request ID if it's not answered within the delay.
+// The first successful response wins. Errors and non-2xx responses are failures: the other request
+// is still awaited, and if both requests fail the result of the last one is returned.
+func (h *Hedger) do(
+	ctx context.Context,
+	req *http.Request,
+	send func(ctx context.Context, req *http.Request) (*Response, error),
+) (*Response, error) {
+	delay := h.start()
+	results := make(chan hedgeResult, 2)
+
+	primaryCtx, cancelPrimary := context.WithCancel(ctx)
+	defer cancelPrimary()
+
+	hedgeCtx, cancelHedge := context.WithCancel(ctx)
+	defer cancelHedge()
+
+	go func() {
+		resp, err := send(primaryCtx, req)
+		results <- hedgeResult{resp: resp, err: err}
+	}()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	pending := 1
+
+	for {
+		select {
+		case <-timer.C:
+			if !h.allow() {
+				continue
+			}
+
+			hedgeReq := req.Clone(hedgeCtx)
+			hedgeReq.Header.Set(requestIDHeader, newRequestID())
+			pending++
+
+			go func() {
+				resp, err := send(hedgeCtx, hedgeReq)
+				results <- hedgeResult{resp: resp, err: err, hedge: true}
+			}()
+		case res := <-results:
+			pending--
+
+			if res.succeeded() {
+				h.observe(res.resp.Meta.Latency, res.hedge)
+				res.resp.Meta.Hedge = res.hedge
+
+				return res.resp, res.err
+			}
+
+			if pending == 0 {
+				return res.resp, res.err
+			}
+		}
+	}
+}
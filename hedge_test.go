@@ -0,0 +1,158 @@
+package domainavailability
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestHedger tests the hedged request wins when the first one is slow and the hedging limits.
+func TestHedger(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"AVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	var calls int32
+
+	release := make(chan struct{})
+	defer close(release)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-release:
+			case <-req.Context().Done():
+			}
+		}
+
+		_, _ = w.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	apiURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hedger := NewHedger(HedgeParams{
+		InitialDelay: 20 * time.Millisecond,
+		MaxRatio:     1,
+		MaxHedges:    1,
+	})
+
+	client := NewClient(apiKey, ClientParams{
+		HTTPClient:                server.Client(),
+		DomainAvailabilityBaseURL: apiURL,
+		Hedger:                    hedger,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	got, _, err := client.Get(ctx, "whoisxmlapi.com")
+	checkErr(t, err, "")
+
+	if !got.Availability.IsAvailable() || !got.Meta.Hedge {
+		t.Errorf("Get() = %s, hedge %v, want AVAILABLE from the hedged request", got.Availability, got.Meta.Hedge)
+	}
+
+	if stats := hedger.Stats(); stats.Requests != 1 || stats.Hedged != 1 || stats.HedgeWins != 1 {
+		t.Errorf("Stats() = %+v, want 1 request, 1 hedged, 1 win", stats)
+	}
+
+	got, _, err = client.Get(ctx, "whoisxmlapi.com")
+	checkErr(t, err, "")
+
+	if got.Meta.Hedge {
+		t.Errorf("Get() hedge = true, want the primary response")
+	}
+
+	if stats := hedger.Stats(); stats.Requests != 2 || stats.Hedged != 1 {
+		t.Errorf("Stats() = %+v, want 2 requests, 1 hedged", stats)
+	}
+}
+
+// TestHedgerFailedResponse tests an error response doesn't win and the other request is awaited.
+func TestHedgerFailedResponse(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"AVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	var calls int32
+
+	hedged := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-hedged:
+			case <-req.Context().Done():
+				return
+			}
+
+			time.Sleep(20 * time.Millisecond)
+			_, _ = w.Write([]byte(resp))
+
+			return
+		}
+
+		close(hedged)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	apiURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hedger := NewHedger(HedgeParams{
+		InitialDelay: 20 * time.Millisecond,
+		MaxRatio:     1,
+	})
+
+	client := NewClient(apiKey, ClientParams{
+		HTTPClient:                server.Client(),
+		DomainAvailabilityBaseURL: apiURL,
+		Hedger:                    hedger,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	got, _, err := client.Get(ctx, "whoisxmlapi.com")
+	checkErr(t, err, "")
+
+	if !got.Availability.IsAvailable() || got.Meta.Hedge {
+		t.Errorf("Get() = %s, hedge %v, want AVAILABLE from the primary request", got.Availability, got.Meta.Hedge)
+	}
+
+	if stats := hedger.Stats(); stats.Hedged != 1 || stats.HedgeWins != 0 {
+		t.Errorf("Stats() = %+v, want 1 hedged, no wins", stats)
+	}
+}
+
+// TestHedgerDelay tests the delay follows the percentile of the observed latencies.
+func TestHedgerDelay(t *testing.T) {
+	hedger := NewHedger(HedgeParams{Percentile: 0.9, InitialDelay: time.Second, MinDelay: 5 * time.Millisecond})
+
+	if d := hedger.Delay(); d != time.Second {
+		t.Errorf("Delay() = %v, want the initial delay", d)
+	}
+
+	for i := 1; i <= 10; i++ {
+		hedger.observe(time.Duration(i)*10*time.Millisecond, false)
+	}
+
+	if d := hedger.Delay(); d != 90*time.Millisecond {
+		t.Errorf("Delay() = %v, want 90ms", d)
+	}
+
+	if hedger.start(); hedger.allow() {
+		t.Errorf("allow() = true, want false above the default ratio")
+	}
+
+	if stats := hedger.Stats(); stats.Suppressed != 1 {
+		t.Errorf("Stats() = %+v, want 1 suppressed", stats)
+	}
+}
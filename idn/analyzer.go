@@ -0,0 +1,408 @@
+// Package idn analyzes internationalized domain names for homograph attacks.
+//
+// The analyzer accepts domain names in Unicode or punycode, detects mixed scripts and characters
+// confusable with Latin letters, computes skeletons for comparison with protected brands and scores the risk.
+//
+// The embedded character data is of Unicode 17.0.0: confusables.txt holds the mappings of
+// https://www.unicode.org/Public/security/17.0.0/confusables.txt (UTS #39) and decompositions.txt the
+// canonical decompositions and combining classes of https://www.unicode.org/Public/17.0.0/ucd/UnicodeData.txt.
+// Scripts are looked up in the tables of the Go unicode package, which are of unicode.Version, 17.0.0 since Go 1.27.
+// With older Go versions characters newer than their tables have no script and aren't reported as mixed scripts.
+package idn
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// Risk levels.
+const (
+	RiskLow    = "low"
+	RiskMedium = "medium"
+	RiskHigh   = "high"
+)
+
+// Risk score contributions.
+const (
+	scoreMixedScript   = 40
+	scoreConfusable    = 10
+	scoreConfusableMax = 30
+	scoreWholeScript   = 40
+	scoreBrandMatch    = 50
+	scoreMax           = 100
+	scoreMediumLevel   = 30
+	scoreHighLevel     = 70
+)
+
+const (
+	// scriptLatin is the name of the Latin script.
+	scriptLatin = "Latin"
+
+	// scriptNone is the script of Common and Inherited characters, e.g. digits and hyphens.
+	scriptNone = ""
+)
+
+// preferredScripts are tried first when detecting the script of a character.
+var preferredScripts = []string{
+	"Latin", "Cyrillic", "Greek", "Armenian", "Han", "Hiragana", "Katakana", "Hangul", "Arabic", "Hebrew",
+}
+
+// allowedCombinations are the script sets which are not considered mixed, see UTS #39 section 5.1.
+var allowedCombinations = [][]string{
+	{"Latin", "Han", "Hiragana", "Katakana"},
+	{"Latin", "Han", "Bopomofo"},
+	{"Latin", "Han", "Hangul"},
+}
+
+// Confusable is a non-ASCII character which looks like an ASCII one.
+type Confusable struct {
+	// Rune is the confusable character.
+	Rune rune
+
+	// Position is the character index in the Unicode domain name.
+	Position int
+
+	// Prototype is the string the character looks like: ASCII characters, possibly with combining marks.
+	Prototype string
+}
+
+// Analysis is the homograph analysis of the domain name.
+type Analysis struct {
+	// DomainName is the domain name in punycode.
+	DomainName string
+
+	// Unicode is the domain name in Unicode.
+	Unicode string
+
+	// Scripts are the scripts of the letters in the order of appearance.
+	Scripts []string
+
+	// MixedScript reports whether a label mixes scripts which are not used together.
+	MixedScript bool
+
+	// WholeScriptConfusable reports whether a label is written in a single non-Latin script
+	// and consists of characters confusable with ASCII ones only.
+	WholeScriptConfusable bool
+
+	// Confusables are the non-ASCII characters confusable with ASCII ones.
+	Confusables []Confusable
+
+	// Skeleton is the skeleton of the Unicode domain name.
+	Skeleton string
+
+	// BrandMatches are the protected brands the domain name can be confused with.
+	BrandMatches []string
+
+	// Score is the risk score from 0 to 100.
+	Score int
+
+	// Risk is the risk level: low|medium|high.
+	Risk string
+}
+
+// Params is used to create Analyzer. None of parameters are mandatory.
+type Params struct {
+	// Brands are the protected domain names or labels, e.g. "paypal.com" or "paypal"
+	Brands []string
+}
+
+// Analyzer analyzes domain names against the protected brands.
+type Analyzer struct {
+	brands []brand
+}
+
+// brand is the protected brand with the precomputed skeleton of its label.
+type brand struct {
+	name     string
+	label    string
+	skeleton string
+}
+
+// New creates Analyzer.
+func New(params Params) *Analyzer {
+	a := &Analyzer{}
+
+	for _, name := range params.Brands {
+		unicodeName, err := ToUnicode(name)
+		if err != nil {
+			unicodeName = strings.ToLower(name)
+		}
+
+		label := firstLabel(unicodeName)
+		if label == "" {
+			continue
+		}
+
+		a.brands = append(a.brands, brand{name: name, label: label, skeleton: Skeleton(label)})
+	}
+
+	return a
+}
+
+// Analyze analyzes the domain name in Unicode or punycode.
+func (a *Analyzer) Analyze(domainName string) (*Analysis, error) {
+	domainName = strings.TrimSuffix(strings.TrimSpace(domainName), ".")
+
+	unicodeName, err := ToUnicode(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	asciiName, err := ToASCII(unicodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := &Analysis{
+		DomainName: asciiName,
+		Unicode:    unicodeName,
+		Skeleton:   Skeleton(unicodeName),
+	}
+
+	seenScripts := make(map[string]bool)
+	position := 0
+
+	for _, label := range strings.Split(unicodeName, ".") {
+		labelScripts := make(map[string]bool)
+		allConfusable := true
+		letters := 0
+
+		for _, r := range label {
+			if script := scriptOf(r); script != scriptNone {
+				labelScripts[script] = true
+				letters++
+
+				if !seenScripts[script] {
+					seenScripts[script] = true
+					analysis.Scripts = append(analysis.Scripts, script)
+				}
+			}
+
+			prototype, ok := prototypeOf(r)
+			ok = ok && isASCIILike(prototype)
+
+			if ok && r > unicode.MaxASCII {
+				analysis.Confusables = append(analysis.Confusables, Confusable{
+					Rune:      r,
+					Position:  position,
+					Prototype: prototype,
+				})
+			}
+
+			if unicode.IsLetter(r) && !ok {
+				allConfusable = false
+			}
+
+			position++
+		}
+
+		position++
+
+		if isMixed(labelScripts) {
+			analysis.MixedScript = true
+		}
+
+		if letters > 0 && allConfusable && len(labelScripts) == 1 && !labelScripts[scriptLatin] {
+			analysis.WholeScriptConfusable = true
+		}
+	}
+
+	label := firstLabel(unicodeName)
+	skeleton := Skeleton(label)
+
+	for _, b := range a.brands {
+		if b.skeleton == skeleton && b.label != label {
+			analysis.BrandMatches = append(analysis.BrandMatches, b.name)
+		}
+	}
+
+	analysis.score()
+
+	return analysis, nil
+}
+
+// score computes the risk score and level.
+func (a *Analysis) score() {
+	score := 0
+
+	if a.MixedScript {
+		score += scoreMixedScript
+	}
+
+	if n := len(a.Confusables) * scoreConfusable; n > scoreConfusableMax {
+		score += scoreConfusableMax
+	} else {
+		score += n
+	}
+
+	if a.WholeScriptConfusable {
+		score += scoreWholeScript
+	}
+
+	if len(a.BrandMatches) > 0 {
+		score += scoreBrandMatch
+	}
+
+	if score > scoreMax {
+		score = scoreMax
+	}
+
+	a.Score = score
+
+	switch {
+	case score >= scoreHighLevel:
+		a.Risk = RiskHigh
+	case score >= scoreMediumLevel:
+		a.Risk = RiskMedium
+	default:
+		a.Risk = RiskLow
+	}
+}
+
+// Result is the availability check result with the homograph analysis attached.
+type Result struct {
+	domainavailability.BulkResult
+
+	// Analysis is the homograph analysis, nil if the domain name could not be analyzed.
+	Analysis *Analysis
+}
+
+// Check analyzes the domain name and checks its punycode form via the service.
+func (a *Analyzer) Check(
+	ctx context.Context,
+	service domainavailability.DomainAvailabilityService,
+	domainName string,
+	opts ...domainavailability.Option,
+) (*Result, error) {
+	analysis, err := a.Analyze(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	domainAvailabilityResp, _, err := service.Get(ctx, analysis.DomainName, opts...)
+
+	return &Result{
+		BulkResult: domainavailability.BulkResult{
+			DomainName:                 analysis.DomainName,
+			DomainAvailabilityResponse: domainAvailabilityResp,
+			Err:                        err,
+		},
+		Analysis: analysis,
+	}, err
+}
+
+// CheckBulk analyzes the domain names and checks them concurrently via domainavailability.GetBulk.
+// Results are returned in the order of domainNames, those which could not be analyzed have the error set.
+func (a *Analyzer) CheckBulk(
+	ctx context.Context,
+	service domainavailability.DomainAvailabilityService,
+	domainNames []string,
+	workers int,
+	opts ...domainavailability.Option,
+) []Result {
+	results := make([]Result, len(domainNames))
+
+	var (
+		asciiNames []string
+		indexes    []int
+	)
+
+	for i, domainName := range domainNames {
+		analysis, err := a.Analyze(domainName)
+		if err != nil {
+			results[i].BulkResult = domainavailability.BulkResult{DomainName: domainName, Err: err}
+			continue
+		}
+
+		results[i].Analysis = analysis
+		asciiNames = append(asciiNames, analysis.DomainName)
+		indexes = append(indexes, i)
+	}
+
+	for j, res := range domainavailability.GetBulk(ctx, service, asciiNames, workers, opts...) {
+		results[indexes[j]].BulkResult = res
+	}
+
+	return results
+}
+
+// scriptOf returns the script of the character or an empty string for Common and Inherited characters.
+func scriptOf(r rune) string {
+	if r <= unicode.MaxASCII && !unicode.IsLetter(r) {
+		return scriptNone
+	}
+
+	for _, name := range preferredScripts {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+
+	if unicode.Is(unicode.Common, r) || unicode.Is(unicode.Inherited, r) {
+		return scriptNone
+	}
+
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+
+	return scriptNone
+}
+
+// isMixed reports whether the set of scripts is mixed and not one of the allowed combinations.
+func isMixed(scripts map[string]bool) bool {
+	if len(scripts) <= 1 {
+		return false
+	}
+
+	for _, allowed := range allowedCombinations {
+		covered := 0
+
+		for _, name := range allowed {
+			if scripts[name] {
+				covered++
+			}
+		}
+
+		if covered == len(scripts) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// firstLabel returns the first label of the domain name in lower case.
+func firstLabel(domainName string) string {
+	domainName = strings.ToLower(strings.Trim(strings.TrimSpace(domainName), "."))
+	if i := strings.IndexByte(domainName, '.'); i >= 0 {
+		return domainName[:i]
+	}
+
+	return domainName
+}
+
+// isASCIILike reports whether s consists of ASCII characters and combining marks only.
+func isASCIILike(s string) bool {
+	for _, r := range s {
+		if r >= utf8.RuneSelf && !unicode.Is(unicode.Mn, r) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,223 @@
+package idn
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// TestAnalyze tests script detection, confusables, brand matching and risk scoring.
+func TestAnalyze(t *testing.T) {
+	analyzer := New(Params{Brands: []string{"apple.com", "paypal", "modern.com"}})
+
+	tests := []struct {
+		name        string
+		domain      string
+		wantASCII   string
+		wantMixed   bool
+		wantWhole   bool
+		confusables int
+		brands      []string
+		risk        string
+	}{
+		{
+			name:      "plain ascii",
+			domain:    "example.com",
+			wantASCII: "example.com",
+			risk:      RiskLow,
+		},
+		{
+			name:      "brand itself",
+			domain:    "Apple.com",
+			wantASCII: "apple.com",
+			risk:      RiskLow,
+		},
+		{
+			name:        "mixed cyrillic a",
+			domain:      "pаypal.com",
+			wantASCII:   "xn--pypal-4ve.com",
+			wantMixed:   true,
+			confusables: 1,
+			brands:      []string{"paypal"},
+			risk:        RiskHigh,
+		},
+		{
+			name:        "whole script cyrillic from punycode",
+			domain:      "xn--80ak6aa92e.com",
+			wantASCII:   "xn--80ak6aa92e.com",
+			wantWhole:   true,
+			confusables: 5,
+			brands:      []string{"apple.com"},
+			risk:        RiskHigh,
+		},
+		{
+			name:      "ascii rn for m",
+			domain:    "rnodern.com",
+			wantASCII: "rnodern.com",
+			brands:    []string{"modern.com"},
+			risk:      RiskMedium,
+		},
+		{
+			name:      "german umlaut",
+			domain:    "bücher.de",
+			wantASCII: "xn--bcher-kva.de",
+			risk:      RiskLow,
+		},
+		{
+			name:        "greek omicrons",
+			domain:      "mοdern.com",
+			wantASCII:   "xn--mdern-rce.com",
+			wantMixed:   true,
+			confusables: 1,
+			brands:      []string{"modern.com"},
+			risk:        RiskHigh,
+		},
+		{
+			name:      "japanese",
+			domain:    "ドメイン名例.jp",
+			wantASCII: "xn--eckwd4c7cu47r2wf.jp",
+			risk:      RiskLow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := analyzer.Analyze(tt.domain)
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+
+			if a.DomainName != tt.wantASCII {
+				t.Errorf("Analyze() DomainName = %s, want %s", a.DomainName, tt.wantASCII)
+			}
+
+			if a.MixedScript != tt.wantMixed {
+				t.Errorf("Analyze() MixedScript = %v, want %v, scripts %v", a.MixedScript, tt.wantMixed, a.Scripts)
+			}
+
+			if a.WholeScriptConfusable != tt.wantWhole {
+				t.Errorf("Analyze() WholeScriptConfusable = %v, want %v", a.WholeScriptConfusable, tt.wantWhole)
+			}
+
+			if len(a.Confusables) != tt.confusables {
+				t.Errorf("Analyze() Confusables = %+v, want %d", a.Confusables, tt.confusables)
+			}
+
+			if len(a.BrandMatches) != len(tt.brands) || len(tt.brands) > 0 && a.BrandMatches[0] != tt.brands[0] {
+				t.Errorf("Analyze() BrandMatches = %v, want %v", a.BrandMatches, tt.brands)
+			}
+
+			if a.Risk != tt.risk {
+				t.Errorf("Analyze() Risk = %s (%d), want %s", a.Risk, a.Score, tt.risk)
+			}
+		})
+	}
+
+	if _, err := analyzer.Analyze("xn--a!b.com"); err == nil {
+		t.Errorf("Analyze() of invalid punycode error = nil, want error")
+	}
+}
+
+// TestSkeleton tests skeletons of confusable strings.
+func TestSkeleton(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{name: "cyrillic", a: "аррӏе", b: "APPLE"},
+		{name: "digits", a: "g00gle", b: "google"},
+		{name: "greek omicron", a: "gοοgle", b: "google"},
+		{name: "latin script g", a: "ɡoogle", b: "google"},
+		{name: "lisu", a: "ꓑaypal", b: "paypal"},
+		{name: "fullwidth", a: "ｐaypal", b: "paypal"},
+		{name: "roman numeral", a: "ⅰbm", b: "ibm"},
+		{name: "small capital s", a: "ꜱhop", b: "shop"},
+		{name: "cyrillic izhitsa", a: "ѵisa", b: "visa"},
+		{name: "soft hyphen", a: "micro\u00adsoft", b: "microsoft"},
+		{name: "zero width joiner", a: "micro\u200dsoft", b: "microsoft"},
+		{name: "precomposed accent", a: "caf\u00e9", b: "cafe\u0301"},
+		{name: "mark order", a: "e\u0301\u0323", b: "\u1eb9\u0301"},
+		{name: "hangul syllable", a: "\ud55c", b: "\u1112\u1161\u11ab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if a, b := Skeleton(tt.a), Skeleton(tt.b); a != b {
+				t.Errorf("Skeleton() = %q and %q, want equal", a, b)
+			}
+		})
+	}
+
+	if a, b := Skeleton("bücher"), Skeleton("bucher"); a == b {
+		t.Errorf("Skeleton() = %q and %q, want different", a, b)
+	}
+}
+
+// asciiService is the DomainAvailabilityService which records the requested names.
+type asciiService struct {
+	requested chan string
+}
+
+// Get reports every domain name as available.
+func (s asciiService) Get(
+	_ context.Context,
+	domainName string,
+	_ ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	s.requested <- domainName
+
+	return &domainavailability.DomainAvailabilityResponse{
+		DomainName:   domainName,
+		Availability: domainavailability.Available,
+	}, nil, nil
+}
+
+// GetRaw is not used by Analyzer.
+func (asciiService) GetRaw(context.Context, string, ...domainavailability.Option) (*domainavailability.Response, error) {
+	return nil, nil
+}
+
+// TestCheck tests the punycode form is checked and the analysis is attached.
+func TestCheck(t *testing.T) {
+	service := asciiService{requested: make(chan string, 2)}
+	analyzer := New(Params{Brands: []string{"paypal.com"}})
+
+	res, err := analyzer.Check(context.Background(), service, "pаypal.com")
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	if got := <-service.requested; got != "xn--pypal-4ve.com" {
+		t.Errorf("Check() requested %s, want xn--pypal-4ve.com", got)
+	}
+
+	if !res.Availability.IsAvailable() || res.Analysis == nil || res.Analysis.Risk != RiskHigh {
+		t.Errorf("Check() = %+v, want available with high risk", res)
+	}
+
+	results := analyzer.CheckBulk(context.Background(), service, []string{"xn--!", "paypal.com"}, 2)
+	if results[0].Err == nil || results[1].Err != nil || results[1].Analysis == nil {
+		t.Errorf("CheckBulk() = %+v", results)
+	}
+}
+
+// TestDataVersion tests that the embedded data files are of the Unicode version of the package doc.
+func TestDataVersion(t *testing.T) {
+	const version = "17.0.0"
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"confusables", confusablesData},
+		{"decompositions", decompositionsData},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := tt.data[:strings.IndexByte(tt.data, '\n')]
+			if !strings.Contains(header, "version "+version) && !strings.Contains(header, "Unicode "+version) {
+				t.Errorf("header = %q, want Unicode %s", header, version)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package idn
+
+import (
+	"bufio"
+	_ "embed"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+//go:embed confusables.txt
+var confusablesData string
+
+// confusables maps characters to their prototypes, loaded from confusablesData,
+// the Unicode confusables.txt data.
+var confusables = parseConfusables(confusablesData)
+
+// parseConfusables parses the data in the format of the Unicode confusables.txt file.
+// It panics on malformed lines as the data is embedded.
+func parseConfusables(data string) map[rune]string {
+	m := make(map[rune]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			panic("idn: malformed confusables line: " + line)
+		}
+
+		source, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 16, 32)
+		if err != nil {
+			panic("idn: malformed confusables source: " + line)
+		}
+
+		var target strings.Builder
+
+		for _, hex := range strings.Fields(fields[1]) {
+			r, err := strconv.ParseUint(hex, 16, 32)
+			if err != nil {
+				panic("idn: malformed confusables target: " + line)
+			}
+
+			target.WriteRune(rune(r))
+		}
+
+		m[rune(source)] = target.String()
+	}
+
+	return m
+}
+
+// Skeleton returns the string used to compare strings for confusability: two strings are confusable
+// if their skeletons are equal. It follows UTS #39 section 4: the string is converted to NFD, default ignorable
+// characters are removed, every character is replaced with its prototype and the result is converted to NFD again.
+// As domain names are case-insensitive, both the string and the result are lower cased.
+func Skeleton(s string) string {
+	var b strings.Builder
+
+	for _, r := range nfd(strings.ToLower(s)) {
+		if isDefaultIgnorable(r) {
+			continue
+		}
+
+		if prototype, ok := confusables[r]; ok {
+			b.WriteString(prototype)
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	return strings.ToLower(nfd(b.String()))
+}
+
+// prototypeOf returns the skeleton of the character and whether it differs from the character itself.
+func prototypeOf(r rune) (string, bool) {
+	prototype := Skeleton(string(r))
+
+	return prototype, prototype != nfd(strings.ToLower(string(r)))
+}
+
+// isDefaultIgnorable reports whether the character has the Default_Ignorable_Code_Point property,
+// which is derived as Other_Default_Ignorable_Code_Point + Cf + Variation_Selector - White_Space -
+// FFF9..FFFB - 13430..1343F - Prepended_Concatenation_Mark.
+func isDefaultIgnorable(r rune) bool {
+	switch {
+	case unicode.Is(unicode.White_Space, r),
+		r >= 0xFFF9 && r <= 0xFFFB,
+		r >= 0x13430 && r <= 0x1343F,
+		unicode.Is(unicode.Prepended_Concatenation_Mark, r):
+		return false
+	}
+
+	return unicode.Is(unicode.Other_Default_Ignorable_Code_Point, r) ||
+		unicode.Is(unicode.Cf, r) ||
+		unicode.Is(unicode.Variation_Selector, r)
+}
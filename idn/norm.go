@@ -0,0 +1,134 @@
+package idn
+
+import (
+	"bufio"
+	_ "embed"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed decompositions.txt
+var decompositionsData string
+
+// Hangul syllable decomposition parameters, see Unicode section 3.12.
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// decompositions maps characters to their full canonical decompositions and
+// combiningClasses maps combining characters to their non-zero canonical combining classes.
+var decompositions, combiningClasses = parseDecompositions(decompositionsData)
+
+// parseDecompositions parses the embedded "code point ; decomposition ; combining class" data.
+// It panics on malformed lines as the data is embedded.
+func parseDecompositions(data string) (map[rune][]rune, map[rune]uint8) {
+	decomposed := make(map[rune][]rune)
+	classes := make(map[rune]uint8)
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) != 3 {
+			panic("idn: malformed decompositions line: " + line)
+		}
+
+		r := parseCodePoint(fields[0], line)
+
+		for _, hex := range strings.Fields(fields[1]) {
+			decomposed[r] = append(decomposed[r], parseCodePoint(hex, line))
+		}
+
+		class, err := strconv.ParseUint(strings.TrimSpace(fields[2]), 10, 8)
+		if err != nil {
+			panic("idn: malformed combining class: " + line)
+		}
+
+		if class != 0 {
+			classes[r] = uint8(class)
+		}
+	}
+
+	return decomposed, classes
+}
+
+// parseCodePoint parses the hexadecimal code point of the embedded data line.
+func parseCodePoint(hex, line string) rune {
+	r, err := strconv.ParseUint(strings.TrimSpace(hex), 16, 32)
+	if err != nil {
+		panic("idn: malformed code point: " + line)
+	}
+
+	return rune(r)
+}
+
+// nfd returns the string in the Unicode Normalization Form D: characters are canonically decomposed
+// and the combining marks are put in the canonical order.
+func nfd(s string) string {
+	runes := make([]rune, 0, len(s))
+
+	for _, r := range s {
+		switch d, ok := decompositions[r]; {
+		case ok:
+			runes = append(runes, d...)
+		case r >= hangulSBase && r < hangulSBase+hangulSCount:
+			runes = appendHangul(runes, r)
+		default:
+			runes = append(runes, r)
+		}
+	}
+
+	// Canonical ordering sorts every run of combining marks by their classes keeping the order of equal ones.
+	for start := 0; start < len(runes); {
+		if combiningClasses[runes[start]] == 0 {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(runes) && combiningClasses[runes[end]] != 0 {
+			end++
+		}
+
+		marks := runes[start:end]
+		sort.SliceStable(marks, func(i, j int) bool {
+			return combiningClasses[marks[i]] < combiningClasses[marks[j]]
+		})
+
+		start = end
+	}
+
+	return string(runes)
+}
+
+// appendHangul appends the jamo of the Hangul syllable.
+func appendHangul(runes []rune, r rune) []rune {
+	s := int(r - hangulSBase)
+
+	runes = append(runes,
+		rune(hangulLBase+s/hangulNCount),
+		rune(hangulVBase+(s%hangulNCount)/hangulTCount))
+
+	if t := s % hangulTCount; t != 0 {
+		runes = append(runes, rune(hangulTBase+t))
+	}
+
+	return runes
+}
@@ -0,0 +1,32 @@
+package idn
+
+import "github.com/whois-api-llc/domain-availability-go/internal/punycode"
+
+var (
+	// ErrPunycodeOverflow is returned when the punycode input encodes too large values.
+	ErrPunycodeOverflow = punycode.ErrOverflow
+
+	// ErrPunycodeInvalid is returned when the punycode input is malformed.
+	ErrPunycodeInvalid = punycode.ErrInvalid
+)
+
+// EncodePunycode encodes the Unicode string to punycode without the ACE prefix, e.g. "bücher" -> "bcher-kva".
+func EncodePunycode(s string) (string, error) {
+	return punycode.Encode(s)
+}
+
+// DecodePunycode decodes the punycode string without the ACE prefix, e.g. "bcher-kva" -> "bücher".
+func DecodePunycode(s string) (string, error) {
+	return punycode.Decode(s)
+}
+
+// ToASCII converts the domain name to lower case and encodes its non-ASCII labels to punycode
+// with the "xn--" prefix, e.g. "Bücher.example" -> "xn--bcher-kva.example".
+func ToASCII(domainName string) (string, error) {
+	return punycode.ToASCII(domainName)
+}
+
+// ToUnicode decodes the "xn--" labels of the domain name, e.g. "xn--bcher-kva.example" -> "bücher.example".
+func ToUnicode(domainName string) (string, error) {
+	return punycode.ToUnicode(domainName)
+}
@@ -0,0 +1,73 @@
+package idn
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestPunycode tests encoding and decoding of punycode labels.
+func TestPunycode(t *testing.T) {
+	tests := []struct {
+		unicode  string
+		punycode string
+	}{
+		{"bücher", "bcher-kva"},
+		{"münchen", "mnchen-3ya"},
+		{"faß", "fa-hia"},
+		{"пример", "e1afmkfd"},
+		{"example", "example-"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.unicode, func(t *testing.T) {
+			got, err := EncodePunycode(tt.unicode)
+			if err != nil || got != tt.punycode {
+				t.Errorf("EncodePunycode() = %q, %v, want %q", got, err, tt.punycode)
+			}
+
+			got, err = DecodePunycode(tt.punycode)
+			if err != nil || got != tt.unicode {
+				t.Errorf("DecodePunycode() = %q, %v, want %q", got, err, tt.unicode)
+			}
+		})
+	}
+
+	for _, s := range []string{"日本語", "ドメイン名例", "аррӏе", "a-b-ü-c"} {
+		encoded, err := EncodePunycode(s)
+		checkErr(t, err, "")
+
+		decoded, err := DecodePunycode(encoded)
+		if err != nil || decoded != s {
+			t.Errorf("round trip of %q = %q, %v", s, decoded, err)
+		}
+	}
+
+	if _, err := DecodePunycode("kva!"); !errors.Is(err, ErrPunycodeInvalid) {
+		t.Errorf("DecodePunycode() error = %v, want %v", err, ErrPunycodeInvalid)
+	}
+
+	if _, err := DecodePunycode("99999999999"); !errors.Is(err, ErrPunycodeOverflow) {
+		t.Errorf("DecodePunycode() error = %v, want %v", err, ErrPunycodeOverflow)
+	}
+}
+
+// TestToASCII tests conversion of domain names.
+func TestToASCII(t *testing.T) {
+	ascii, err := ToASCII("Bücher.Example")
+	if err != nil || ascii != "xn--bcher-kva.example" {
+		t.Errorf("ToASCII() = %q, %v", ascii, err)
+	}
+
+	unicode, err := ToUnicode("XN--BCHER-KVA.example")
+	if err != nil || unicode != "bücher.example" {
+		t.Errorf("ToUnicode() = %q, %v", unicode, err)
+	}
+}
+
+// checkErr checks for an error.
+func checkErr(t *testing.T, err error, want string) {
+	t.Helper()
+
+	if (err != nil || want != "") && (err == nil || err.Error() != want) {
+		t.Errorf("error = %v, wantErr %v", err, want)
+	}
+}
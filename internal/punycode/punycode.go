@@ -0,0 +1,281 @@
+// Package punycode implements the punycode encoding of RFC 3492 and
+// the conversion of internationalized domain names to and from their ASCII form.
+package punycode
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// Punycode parameters, see RFC 3492 section 5.
+const (
+	base        = 36
+	tMin        = 1
+	tMax        = 26
+	skew        = 38
+	damp        = 700
+	initialBias = 72
+	initialN    = 128
+
+	// maxCodePoint bounds the decoded values, larger ones are rejected as overflows.
+	maxCodePoint = int(utf8.MaxRune)
+
+	// acePrefix marks the punycode encoded labels.
+	acePrefix = "xn--"
+)
+
+var (
+	// ErrOverflow is returned when the punycode input encodes too large values.
+	ErrOverflow = errors.New("punycode: overflow")
+
+	// ErrInvalid is returned when the punycode input is malformed.
+	ErrInvalid = errors.New("punycode: invalid input")
+)
+
+// adapt is the bias adaptation function of RFC 3492 section 6.1.
+func adapt(delta, numPoints int, first bool) int {
+	if first {
+		delta /= damp
+	} else {
+		delta /= 2
+	}
+
+	delta += delta / numPoints
+
+	k := 0
+	for delta > ((base-tMin)*tMax)/2 {
+		delta /= base - tMin
+		k += base
+	}
+
+	return k + (base-tMin+1)*delta/(delta+skew)
+}
+
+// threshold returns the digit threshold for the position k.
+func threshold(k, bias int) int {
+	switch {
+	case k <= bias:
+		return tMin
+	case k >= bias+tMax:
+		return tMax
+	default:
+		return k - bias
+	}
+}
+
+// encodeDigit returns the character for the digit 0..35.
+func encodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+
+	return byte('0' + d - 26)
+}
+
+// decodeDigit returns the digit for the character, false if it's not a digit.
+func decodeDigit(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c-'0') + 26, true
+	case c >= 'a' && c <= 'z':
+		return int(c - 'a'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c - 'A'), true
+	default:
+		return 0, false
+	}
+}
+
+// Encode encodes the Unicode string to punycode without the ACE prefix, e.g. "bücher" -> "bcher-kva".
+func Encode(s string) (string, error) {
+	input := []rune(s)
+
+	var out strings.Builder
+
+	for _, r := range input {
+		if r < 0x80 {
+			out.WriteByte(byte(r))
+		}
+	}
+
+	b := out.Len()
+	h := b
+
+	if b > 0 {
+		out.WriteByte('-')
+	}
+
+	n, delta, bias := initialN, 0, initialBias
+
+	for h < len(input) {
+		m := maxCodePoint + 1
+		for _, r := range input {
+			if int(r) >= n && int(r) < m {
+				m = int(r)
+			}
+		}
+
+		if (m-n)*(h+1) > maxCodePoint*len(input) {
+			return "", ErrOverflow
+		}
+
+		delta += (m - n) * (h + 1)
+		n = m
+
+		for _, r := range input {
+			if int(r) < n {
+				delta++
+			}
+
+			if int(r) != n {
+				continue
+			}
+
+			q := delta
+
+			for k := base; ; k += base {
+				t := threshold(k, bias)
+				if q < t {
+					break
+				}
+
+				out.WriteByte(encodeDigit(t + (q-t)%(base-t)))
+				q = (q - t) / (base - t)
+			}
+
+			out.WriteByte(encodeDigit(q))
+
+			bias = adapt(delta, h+1, h == b)
+			delta = 0
+			h++
+		}
+
+		delta++
+		n++
+	}
+
+	return out.String(), nil
+}
+
+// Decode decodes the punycode string without the ACE prefix, e.g. "bcher-kva" -> "bücher".
+func Decode(s string) (string, error) {
+	var output []rune
+
+	pos := 0
+
+	if b := strings.LastIndexByte(s, '-'); b >= 0 {
+		for i := 0; i < b; i++ {
+			if s[i] >= 0x80 {
+				return "", ErrInvalid
+			}
+
+			output = append(output, rune(s[i]))
+		}
+
+		pos = b + 1
+	}
+
+	n, i, bias := initialN, 0, initialBias
+
+	for pos < len(s) {
+		oldi, w := i, 1
+
+		for k := base; ; k += base {
+			if pos >= len(s) {
+				return "", ErrInvalid
+			}
+
+			digit, ok := decodeDigit(s[pos])
+			if !ok {
+				return "", ErrInvalid
+			}
+
+			pos++
+
+			if digit > (maxCodePoint*base-i)/w {
+				return "", ErrOverflow
+			}
+
+			i += digit * w
+
+			t := threshold(k, bias)
+			if digit < t {
+				break
+			}
+
+			w *= base - t
+		}
+
+		bias = adapt(i-oldi, len(output)+1, oldi == 0)
+		n += i / (len(output) + 1)
+		i %= len(output) + 1
+
+		if n > maxCodePoint {
+			return "", ErrOverflow
+		}
+
+		if n >= 0xD800 && n <= 0xDFFF {
+			return "", ErrInvalid
+		}
+
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = rune(n)
+		i++
+	}
+
+	return string(output), nil
+}
+
+// ToASCII converts the domain name to lower case and encodes its non-ASCII labels to punycode
+// with the "xn--" prefix, e.g. "Bücher.example" -> "xn--bcher-kva.example".
+func ToASCII(domainName string) (string, error) {
+	labels := strings.Split(strings.ToLower(strings.TrimSpace(domainName)), ".")
+
+	for i, label := range labels {
+		if isASCII(label) {
+			continue
+		}
+
+		encoded, err := Encode(label)
+		if err != nil {
+			return "", err
+		}
+
+		labels[i] = acePrefix + encoded
+	}
+
+	return strings.Join(labels, "."), nil
+}
+
+// ToUnicode decodes the "xn--" labels of the domain name, e.g. "xn--bcher-kva.example" -> "bücher.example".
+func ToUnicode(domainName string) (string, error) {
+	labels := strings.Split(strings.ToLower(strings.TrimSpace(domainName)), ".")
+
+	for i, label := range labels {
+		if !strings.HasPrefix(label, acePrefix) {
+			continue
+		}
+
+		decoded, err := Decode(label[len(acePrefix):])
+		if err != nil {
+			return "", err
+		}
+
+		labels[i] = decoded
+	}
+
+	return strings.Join(labels, "."), nil
+}
+
+// isASCII reports whether s consists of ASCII characters only.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
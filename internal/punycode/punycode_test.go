@@ -0,0 +1,186 @@
+package punycode
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestRFC3492Samples tests encoding and decoding of the sample strings of RFC 3492 section 7.1.
+func TestRFC3492Samples(t *testing.T) {
+	tests := []struct {
+		name     string
+		unicode  string
+		punycode string
+	}{
+		{
+			name:     "A Arabic (Egyptian)",
+			unicode:  "\u0644\u064a\u0647\u0645\u0627\u0628\u062a\u0643\u0644\u0645\u0648\u0634\u0639\u0631\u0628\u064a\u061f",
+			punycode: "egbpdaj6bu4bxfgehfvwxn",
+		},
+		{
+			name:     "B Chinese (simplified)",
+			unicode:  "\u4ed6\u4eec\u4e3a\u4ec0\u4e48\u4e0d\u8bf4\u4e2d\u6587",
+			punycode: "ihqwcrb4cv8a8dqg056pqjye",
+		},
+		{
+			name:     "C Chinese (traditional)",
+			unicode:  "\u4ed6\u5011\u7232\u4ec0\u9ebd\u4e0d\u8aaa\u4e2d\u6587",
+			punycode: "ihqwctvzc91f659drss3x8bo0yb",
+		},
+		{
+			name:     "D Czech",
+			unicode:  "Pro\u010dprost\u011bnemluv\u00ed\u010desky",
+			punycode: "Proprostnemluvesky-uyb24dma41a",
+		},
+		{
+			name:     "E Hebrew",
+			unicode:  "\u05dc\u05de\u05d4\u05d4\u05dd\u05e4\u05e9\u05d5\u05d8\u05dc\u05d0\u05de\u05d3\u05d1\u05e8\u05d9\u05dd\u05e2\u05d1\u05e8\u05d9\u05ea",
+			punycode: "4dbcagdahymbxekheh6e0a7fei0b",
+		},
+		{
+			name:     "F Hindi (Devanagari)",
+			unicode:  "\u092f\u0939\u0932\u094b\u0917\u0939\u093f\u0928\u094d\u0926\u0940\u0915\u094d\u092f\u094b\u0902\u0928\u0939\u0940\u0902\u092c\u094b\u0932\u0938\u0915\u0924\u0947\u0939\u0948\u0902",
+			punycode: "i1baa7eci9glrd9b2ae1bj0hfcgg6iyaf8o0a1dig0cd",
+		},
+		{
+			name:     "G Japanese (kanji and hiragana)",
+			unicode:  "\u306a\u305c\u307f\u3093\u306a\u65e5\u672c\u8a9e\u3092\u8a71\u3057\u3066\u304f\u308c\u306a\u3044\u306e\u304b",
+			punycode: "n8jok5ay5dzabd5bym9f0cm5685rrjetr6pdxa",
+		},
+		{
+			name:     "H Korean (Hangul syllables)",
+			unicode:  "\uc138\uacc4\uc758\ubaa8\ub4e0\uc0ac\ub78c\ub4e4\uc774\ud55c\uad6d\uc5b4\ub97c\uc774\ud574\ud55c\ub2e4\uba74\uc5bc\ub9c8\ub098\uc88b\uc744\uae4c",
+			punycode: "989aomsvi5e83db1d2a355cv1e0vak1dwrv93d5xbh15a0dt30a5jpsd879ccm6fea98c",
+		},
+		{
+			name:     "I Russian (Cyrillic)",
+			unicode:  "\u043f\u043e\u0447\u0435\u043c\u0443\u0436\u0435\u043e\u043d\u0438\u043d\u0435\u0433\u043e\u0432\u043e\u0440\u044f\u0442\u043f\u043e\u0440\u0443\u0441\u0441\u043a\u0438",
+			punycode: "b1abfaaepdrnnbgefbadotcwatmq2g4l",
+		},
+		{
+			name:     "J Spanish",
+			unicode:  "Porqu\u00e9nopuedensimplementehablarenEspa\u00f1ol",
+			punycode: "PorqunopuedensimplementehablarenEspaol-fmd56a",
+		},
+		{
+			name:     "K Vietnamese",
+			unicode:  "T\u1ea1isaoh\u1ecdkh\u00f4ngth\u1ec3ch\u1ec9n\u00f3iti\u1ebfngVi\u1ec7t",
+			punycode: "TisaohkhngthchnitingVit-kjcr8268qyxafd2f1b9g",
+		},
+		{
+			name:     "L 3<nen>B<gumi><kinpachi><sensei>",
+			unicode:  "3\u5e74B\u7d44\u91d1\u516b\u5148\u751f",
+			punycode: "3B-ww4c5e180e575a65lsy2b",
+		},
+		{
+			name:     "M <amuro><namie>-with-SUPER-MONKEYS",
+			unicode:  "\u5b89\u5ba4\u5948\u7f8e\u6075-with-SUPER-MONKEYS",
+			punycode: "-with-SUPER-MONKEYS-pc58ag80a8qai00g7n9n",
+		},
+		{
+			name:     "N Hello-Another-Way-<sorezore><no><basho>",
+			unicode:  "Hello-Another-Way-\u305d\u308c\u305e\u308c\u306e\u5834\u6240",
+			punycode: "Hello-Another-Way--fc4qua05auwb3674vfr0b",
+		},
+		{
+			name:     "O <hitotsu><yane><no><shita>2",
+			unicode:  "\u3072\u3068\u3064\u5c4b\u6839\u306e\u4e0b2",
+			punycode: "2-u9tlzr9756bt3uc0v",
+		},
+		{
+			name:     "P Maji<de>Koi<suru>5<byou><mae>",
+			unicode:  "Maji\u3067Koi\u3059\u308b5\u79d2\u524d",
+			punycode: "MajiKoi5-783gue6qz075azm5e",
+		},
+		{
+			name:     "Q <pafii>de<runba>",
+			unicode:  "\u30d1\u30d5\u30a3\u30fcde\u30eb\u30f3\u30d0",
+			punycode: "de-jg4avhby1noc0d",
+		},
+		{
+			name:     "R <sono><supiido><de>",
+			unicode:  "\u305d\u306e\u30b9\u30d4\u30fc\u30c9\u3067",
+			punycode: "d9juau41awczczp",
+		},
+		{
+			name:     "S -> $1.00 <-",
+			unicode:  "-> $1.00 <-",
+			punycode: "-> $1.00 <--",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Encode(tt.unicode)
+			if err != nil || got != tt.punycode {
+				t.Errorf("Encode() = %q, %v, want %q", got, err, tt.punycode)
+			}
+
+			got, err = Decode(tt.punycode)
+			if err != nil || got != tt.unicode {
+				t.Errorf("Decode() = %q, %v, want %q", got, err, tt.unicode)
+			}
+		})
+	}
+}
+
+// TestDecodeErrors tests malformed and overflowing punycode input is rejected.
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{name: "non-ASCII basic code point", input: "ü-abc", want: ErrInvalid},
+		{name: "invalid digit", input: "abc!", want: ErrInvalid},
+		{name: "truncated delta", input: "bcher-kv", want: ErrInvalid},
+		{name: "surrogate", input: "ib9b", want: ErrInvalid},
+		{name: "surrogate after basic", input: "a-qo7g", want: ErrInvalid},
+		{name: "delta overflow", input: "99999999999", want: ErrOverflow},
+		{name: "long delta overflow", input: "wd0000000", want: ErrOverflow},
+		{name: "code point above U+10FFFF", input: "dn32h", want: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := Decode(tt.input); !errors.Is(err, tt.want) {
+				t.Errorf("Decode() = %q, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+
+	if got, err := Decode("dn32g"); err != nil || got != "\U0010FFFF" {
+		t.Errorf("Decode() of U+10FFFF = %q, %v", got, err)
+	}
+}
+
+// TestToASCII tests conversion of domain names to and from their ASCII form.
+func TestToASCII(t *testing.T) {
+	tests := []struct {
+		unicode string
+		ascii   string
+	}{
+		{unicode: "bücher.example", ascii: "xn--bcher-kva.example"},
+		{unicode: "пример.испытание", ascii: "xn--e1afmkfd.xn--80akhbyknj4f"},
+		{unicode: "example.com.", ascii: "example.com."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.unicode, func(t *testing.T) {
+			got, err := ToASCII(tt.unicode)
+			if err != nil || got != tt.ascii {
+				t.Errorf("ToASCII() = %q, %v, want %q", got, err, tt.ascii)
+			}
+
+			got, err = ToUnicode(tt.ascii)
+			if err != nil || got != tt.unicode {
+				t.Errorf("ToUnicode() = %q, %v, want %q", got, err, tt.unicode)
+			}
+		})
+	}
+
+	if got, err := ToASCII(" Bücher.Example "); err != nil || got != "xn--bcher-kva.example" {
+		t.Errorf("ToASCII() = %q, %v, want lower case without spaces", got, err)
+	}
+
+	if _, err := ToUnicode("xn--abc!.example"); !errors.Is(err, ErrInvalid) {
+		t.Errorf("ToUnicode() error = %v, want %v", err, ErrInvalid)
+	}
+}
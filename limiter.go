@@ -0,0 +1,297 @@
+package domainavailability
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+)
+
+// Default adaptive limiter parameters.
+const (
+	defaultInitialLimit = 4
+	defaultMinLimit     = 1
+	defaultMaxLimit     = 64
+	defaultBackoff      = 0.5
+)
+
+// Outcome is the result of a limited request as a signal for the adaptive limiter.
+type Outcome int
+
+const (
+	// OutcomeSuccess means the service answered, the limit is increased.
+	OutcomeSuccess Outcome = iota
+
+	// OutcomeOverload means the service throttled or failed, the limit is decreased.
+	OutcomeOverload
+
+	// OutcomeIgnored carries no signal about the service capacity, e.g. an invalid argument.
+	OutcomeIgnored
+)
+
+// LimiterParams is used to create AdaptiveLimiter. None of parameters are mandatory.
+type LimiterParams struct {
+	// InitialLimit is the concurrency limit to start with
+	// If it's zero then 4 is used
+	InitialLimit int
+
+	// MinLimit is the lowest concurrency limit
+	// If it's zero then 1 is used
+	MinLimit int
+
+	// MaxLimit is the highest concurrency limit
+	// If it's zero then 64 is used
+	MaxLimit int
+
+	// Backoff is the factor the limit is multiplied by on overload, from 0 to 1
+	// If it's zero then 0.5 is used
+	Backoff float64
+
+	// OnLimitChange is called when the integer limit changes
+	// It's called synchronously, so it must not block
+	OnLimitChange func(limit int)
+}
+
+// AdaptiveLimiter limits the number of concurrent requests using additive increase/multiplicative decrease:
+// every success raises the limit by 1/limit, so it grows by one per round of requests while the service is healthy,
+// and throttling (429), 5xx status codes and timeouts multiply it by Backoff at most once per round.
+type AdaptiveLimiter struct {
+	params LimiterParams
+
+	mu       sync.Mutex
+	limit    float64
+	inFlight int
+
+	// sinceDecrease is the number of completed requests since the last decrease
+	sinceDecrease int
+
+	// waiters are granted slots in FIFO order
+	waiters []chan struct{}
+}
+
+// NewAdaptiveLimiter creates AdaptiveLimiter.
+func NewAdaptiveLimiter(params LimiterParams) *AdaptiveLimiter {
+	if params.MinLimit <= 0 {
+		params.MinLimit = defaultMinLimit
+	}
+
+	if params.MaxLimit <= 0 {
+		params.MaxLimit = defaultMaxLimit
+	}
+
+	if params.MaxLimit < params.MinLimit {
+		params.MaxLimit = params.MinLimit
+	}
+
+	if params.InitialLimit <= 0 {
+		params.InitialLimit = defaultInitialLimit
+	}
+
+	if params.InitialLimit < params.MinLimit {
+		params.InitialLimit = params.MinLimit
+	}
+
+	if params.InitialLimit > params.MaxLimit {
+		params.InitialLimit = params.MaxLimit
+	}
+
+	if params.Backoff <= 0 || params.Backoff >= 1 {
+		params.Backoff = defaultBackoff
+	}
+
+	return &AdaptiveLimiter{
+		params:        params,
+		limit:         float64(params.InitialLimit),
+		sinceDecrease: params.InitialLimit,
+	}
+}
+
+// Limit returns the current concurrency limit.
+func (l *AdaptiveLimiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return int(l.limit)
+}
+
+// InFlight returns the number of acquired slots.
+func (l *AdaptiveLimiter) InFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.inFlight
+}
+
+// MaxLimit returns the highest concurrency limit.
+func (l *AdaptiveLimiter) MaxLimit() int {
+	return l.params.MaxLimit
+}
+
+// Acquire waits for a free slot. Every successful Acquire must be followed by Release.
+func (l *AdaptiveLimiter) Acquire(ctx context.Context) error {
+	l.mu.Lock()
+
+	if len(l.waiters) == 0 && l.inFlight < int(l.limit) {
+		l.inFlight++
+		l.mu.Unlock()
+
+		return nil
+	}
+
+	ready := make(chan struct{})
+	l.waiters = append(l.waiters, ready)
+	l.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		for i, w := range l.waiters {
+			if w == ready {
+				l.waiters = append(l.waiters[:i], l.waiters[i+1:]...)
+				return ctx.Err()
+			}
+		}
+
+		// The slot was granted concurrently with the cancellation.
+		l.inFlight--
+		l.wake()
+
+		return ctx.Err()
+	}
+}
+
+// Release frees the slot and adjusts the limit by the outcome of the request.
+func (l *AdaptiveLimiter) Release(outcome Outcome) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	before := int(l.limit)
+
+	l.inFlight--
+	l.sinceDecrease++
+
+	switch outcome {
+	case OutcomeSuccess:
+		l.limit += 1 / l.limit
+		if l.limit > float64(l.params.MaxLimit) {
+			l.limit = float64(l.params.MaxLimit)
+		}
+	case OutcomeOverload:
+		if l.sinceDecrease >= before {
+			l.limit *= l.params.Backoff
+			if l.limit < float64(l.params.MinLimit) {
+				l.limit = float64(l.params.MinLimit)
+			}
+
+			l.sinceDecrease = 0
+		}
+	}
+
+	if after := int(l.limit); after != before && l.params.OnLimitChange != nil {
+		l.params.OnLimitChange(after)
+	}
+
+	l.wake()
+}
+
+// wake grants slots to the waiters while the limit allows. The mutex must be held.
+func (l *AdaptiveLimiter) wake() {
+	for len(l.waiters) > 0 && l.inFlight < int(l.limit) {
+		close(l.waiters[0])
+		l.waiters = l.waiters[1:]
+		l.inFlight++
+	}
+}
+
+// limitedService is the DomainAvailabilityService limited by AdaptiveLimiter.
+type limitedService struct {
+	service DomainAvailabilityService
+	limiter *AdaptiveLimiter
+}
+
+// Limited wraps the service, so its calls are limited by the adaptive limiter.
+// Bulk operations should use at least limiter.MaxLimit() workers to let the limit grow.
+func Limited(service DomainAvailabilityService, limiter *AdaptiveLimiter) DomainAvailabilityService {
+	return &limitedService{service: service, limiter: limiter}
+}
+
+// Get calls service.Get when the limiter allows.
+func (s *limitedService) Get(
+	ctx context.Context,
+	domainName string,
+	opts ...Option,
+) (*DomainAvailabilityResponse, *Response, error) {
+	if err := s.limiter.Acquire(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	domainAvailabilityResp, resp, err := s.service.Get(ctx, domainName, opts...)
+	s.limiter.Release(outcomeOf(ctx, resp, err))
+
+	return domainAvailabilityResp, resp, err
+}
+
+// GetRaw calls service.GetRaw when the limiter allows.
+func (s *limitedService) GetRaw(ctx context.Context, domainName string, opts ...Option) (*Response, error) {
+	if err := s.limiter.Acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.service.GetRaw(ctx, domainName, opts...)
+	s.limiter.Release(outcomeOf(ctx, resp, err))
+
+	return resp, err
+}
+
+// GetBulkAdaptive checks the domain names like GetBulk with the concurrency controlled by the limiter.
+func GetBulkAdaptive(
+	ctx context.Context,
+	service DomainAvailabilityService,
+	domainNames []string,
+	limiter *AdaptiveLimiter,
+	opts ...Option,
+) []BulkResult {
+	return GetBulk(ctx, Limited(service, limiter), domainNames, limiter.MaxLimit(), opts...)
+}
+
+// outcomeOf classifies the result of the call.
+func outcomeOf(ctx context.Context, resp *Response, err error) Outcome {
+	if ctx.Err() != nil {
+		return OutcomeIgnored
+	}
+
+	if resp != nil && resp.Response != nil && isOverloadStatus(resp.StatusCode) {
+		return OutcomeOverload
+	}
+
+	if err == nil {
+		return OutcomeSuccess
+	}
+
+	var (
+		errResp *ErrorResponse
+		errMsg  *ErrorMessage
+		netErr  net.Error
+	)
+
+	switch {
+	case errors.As(err, &errResp) && errResp.Response != nil && isOverloadStatus(errResp.Response.StatusCode),
+		errors.As(err, &errMsg) && (errMsg.Code == "429" || errMsg.Code == "503"),
+		errors.Is(err, ErrCircuitOpen),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.As(err, &netErr) && netErr.Timeout():
+		return OutcomeOverload
+	}
+
+	return OutcomeIgnored
+}
+
+// isOverloadStatus reports whether the status code signals the service is overloaded.
+func isOverloadStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
@@ -0,0 +1,180 @@
+package domainavailability
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestAdaptiveLimiter tests acquiring slots and the AIMD limit changes.
+func TestAdaptiveLimiter(t *testing.T) {
+	var changes []int
+
+	limiter := NewAdaptiveLimiter(LimiterParams{
+		InitialLimit:  2,
+		MaxLimit:      4,
+		OnLimitChange: func(limit int) { changes = append(changes, limit) },
+	})
+
+	ctx := context.Background()
+
+	checkErr(t, limiter.Acquire(ctx), "")
+	checkErr(t, limiter.Acquire(ctx), "")
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Acquire(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Acquire() above the limit error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	granted := make(chan error)
+
+	go func() {
+		granted <- limiter.Acquire(ctx)
+	}()
+
+	limiter.Release(OutcomeSuccess)
+	checkErr(t, <-granted, "")
+
+	for i := 0; i < 20; i++ {
+		limiter.Release(OutcomeSuccess)
+		checkErr(t, limiter.Acquire(ctx), "")
+	}
+
+	if limit := limiter.Limit(); limit != 4 {
+		t.Errorf("Limit() after successes = %d, want 4", limit)
+	}
+
+	limiter.Release(OutcomeOverload)
+	limiter.Release(OutcomeOverload)
+
+	if limit := limiter.Limit(); limit != 2 {
+		t.Errorf("Limit() after overloads = %d, want 2 (one decrease per round)", limit)
+	}
+
+	if inFlight := limiter.InFlight(); inFlight != 0 {
+		t.Errorf("InFlight() = %d, want 0", inFlight)
+	}
+
+	want := []int{3, 4, 2}
+	if len(changes) != len(want) {
+		t.Fatalf("OnLimitChange calls = %v, want %v", changes, want)
+	}
+
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("OnLimitChange calls = %v, want %v", changes, want)
+		}
+	}
+}
+
+// throttlingService throttles calls above the capacity.
+type throttlingService struct {
+	capacity int
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+// Get fails with 429 and the API error like Client.Get when more than capacity calls are in flight.
+func (s *throttlingService) Get(
+	_ context.Context,
+	domainName string,
+	_ ...Option,
+) (*DomainAvailabilityResponse, *Response, error) {
+	s.mu.Lock()
+	s.inFlight++
+
+	if s.inFlight > s.maxInFlight {
+		s.maxInFlight = s.inFlight
+	}
+
+	throttled := s.inFlight > s.capacity
+	s.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	s.mu.Lock()
+	s.inFlight--
+	s.mu.Unlock()
+
+	if throttled {
+		return nil, &Response{Response: &http.Response{StatusCode: http.StatusTooManyRequests}},
+			&ErrorMessage{Code: "WHOIS_09", Message: "Too many requests."}
+	}
+
+	return &DomainAvailabilityResponse{DomainName: domainName, Availability: Available}, nil, nil
+}
+
+// GetRaw is not used by the test.
+func (s *throttlingService) GetRaw(context.Context, string, ...Option) (*Response, error) {
+	return nil, nil
+}
+
+// TestGetBulkAdaptive tests the limit backs off on throttling and never exceeds the maximum.
+func TestGetBulkAdaptive(t *testing.T) {
+	service := &throttlingService{capacity: 3}
+	limiter := NewAdaptiveLimiter(LimiterParams{InitialLimit: 8, MaxLimit: 8})
+
+	domainNames := make([]string, 200)
+	for i := range domainNames {
+		domainNames[i] = "whoisxmlapi.com"
+	}
+
+	results := GetBulkAdaptive(context.Background(), service, domainNames, limiter)
+
+	if len(results) != len(domainNames) {
+		t.Fatalf("GetBulkAdaptive() = %d results, want %d", len(results), len(domainNames))
+	}
+
+	if service.maxInFlight > 8 {
+		t.Errorf("max in flight = %d, want at most 8", service.maxInFlight)
+	}
+
+	if limit := limiter.Limit(); limit >= 8 {
+		t.Errorf("Limit() = %d, want below 8 after throttling", limit)
+	}
+
+	if inFlight := limiter.InFlight(); inFlight != 0 {
+		t.Errorf("InFlight() = %d, want 0", inFlight)
+	}
+}
+
+// TestLimitedThrottlingErrorBody tests the limit backs off when the API answers 429 with a JSON error body.
+func TestLimitedThrottlingErrorBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"ErrorMessage":{"errorCode":"WHOIS_09","msg":"Too many requests."}}`))
+	}))
+	defer server.Close()
+
+	apiURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewClient(apiKey, ClientParams{HTTPClient: server.Client(), DomainAvailabilityBaseURL: apiURL})
+	limiter := NewAdaptiveLimiter(LimiterParams{InitialLimit: 8})
+
+	_, resp, err := Limited(client, limiter).Get(context.Background(), "whoisxmlapi.com")
+
+	var errMsg *ErrorMessage
+	if !errors.As(err, &errMsg) || errMsg.Code != "WHOIS_09" {
+		t.Fatalf("Get() error = %v, want the API error", err)
+	}
+
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Get() response = %v, want the 429 response", resp)
+	}
+
+	if limit := limiter.Limit(); limit != 4 {
+		t.Errorf("Limit() = %d, want 4 after the throttled request", limit)
+	}
+}
@@ -0,0 +1,91 @@
+package domainavailability
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+)
+
+// requestIDHeader is the header carrying the request ID.
+const requestIDHeader = "X-Request-Id"
+
+// Source tells where the result came from.
+type Source string
+
+const (
+	// SourceNetwork is the result of the API request made for the call.
+	SourceNetwork Source = "network"
+
+	// SourceCoalesced is the result of the API request shared with a concurrent identical call.
+	SourceCoalesced Source = "coalesced"
+
+	// SourceCache is the result saved earlier and returned without calling the API.
+	SourceCache Source = "cache"
+
+	// SourceZone is the result inferred offline from the registry zone file.
+	SourceZone Source = "zone"
+)
+
+// ResponseMeta describes how and when the result was obtained.
+type ResponseMeta struct {
+	// CheckedAt is the time the response was received.
+	CheckedAt time.Time `json:"checkedAt"`
+
+	// Latency is the duration of the API request.
+	Latency time.Duration `json:"latency"`
+
+	// Mode is the effective check mode: DNS_AND_WHOIS|DNS_ONLY.
+	Mode string `json:"mode"`
+
+	// Credits is the effective type of credits: DA|WHOIS.
+	Credits string `json:"credits"`
+
+	// StatusCode is the HTTP status code, zero if no response was received.
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// RequestID is the ID returned by the server in the X-Request-Id header
+	// or the ID generated by the client if the server didn't return one.
+	RequestID string `json:"requestId"`
+
+	// Source tells where the result came from.
+	Source Source `json:"source"`
+
+	// Hedge reports whether the response is of the hedged request sent after the first one was slow.
+	Hedge bool `json:"hedge,omitempty"`
+}
+
+// newResponseMeta builds ResponseMeta of the API request sent at start.
+func newResponseMeta(req *http.Request, resp *http.Response, start time.Time) ResponseMeta {
+	now := time.Now()
+	mode, credits := modeAndCredits(req.URL.Query().Get)
+
+	meta := ResponseMeta{
+		CheckedAt: now.UTC(),
+		Latency:   now.Sub(start),
+		Mode:      mode,
+		Credits:   credits,
+		RequestID: req.Header.Get(requestIDHeader),
+		Source:    SourceNetwork,
+	}
+
+	if resp != nil {
+		meta.StatusCode = resp.StatusCode
+
+		if id := resp.Header.Get(requestIDHeader); id != "" {
+			meta.RequestID = id
+		}
+	}
+
+	return meta
+}
+
+// newRequestID returns a random 128-bit hex encoded ID.
+func newRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,71 @@
+package domainavailability
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestResponseMeta tests the metadata of the Get response.
+func TestResponseMeta(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"UNAVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	var sentID string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		sentID = req.Header.Get(requestIDHeader)
+
+		if req.URL.Query().Get("credits") == "DA" {
+			w.Header().Set(requestIDHeader, "server-id")
+		}
+
+		_, _ = w.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	client, err := New(apiKey, WithHTTPClient(server.Client()), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	domainAvailabilityResp, raw, err := client.Get(context.Background(), "whoisxmlapi.com")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	meta := domainAvailabilityResp.Meta
+	if meta == nil {
+		t.Fatal("Get() Meta = nil")
+	}
+
+	if meta.Mode != "DNS_ONLY" || meta.Credits != "WHOIS" || meta.StatusCode != http.StatusOK ||
+		meta.Source != SourceNetwork || meta.CheckedAt.IsZero() || meta.Latency <= 0 {
+		t.Errorf("Get() Meta = %+v", meta)
+	}
+
+	if sentID == "" || meta.RequestID != sentID || raw.Meta.RequestID != sentID {
+		t.Errorf("Get() Meta.RequestID = %v, want %v", meta.RequestID, sentID)
+	}
+
+	domainAvailabilityResp, _, err = client.Get(context.Background(), "whoisxmlapi.com",
+		OptionMode("DNS_AND_WHOIS"), OptionCredits("DA"))
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if meta = domainAvailabilityResp.Meta; meta.Mode != "DNS_AND_WHOIS" || meta.Credits != "DA" ||
+		meta.RequestID != "server-id" {
+		t.Errorf("Get() Meta = %+v", meta)
+	}
+
+	b, err := json.Marshal(domainAvailabilityResp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(b), `{"domainName":"whoisxmlapi.com","domainAvailability":"UNAVAILABLE"}`; got != want {
+		t.Errorf("json.Marshal() = %v, want %v", got, want)
+	}
+}
@@ -16,6 +16,8 @@ func unmarshalString(raw json.RawMessage) (string, error) {
 }
 
 // StringBool is a helper wrapper on bool
+//
+// Deprecated: use Availability, which also represents unknown and unrecognized states.
 type StringBool bool
 
 // UnmarshalJSON decodes AVAILABLE/UNAVAILABLE values from Domain Availability API.
@@ -49,8 +51,100 @@ type DomainAvailabilityResponse struct {
 	// DomainName is the target domain name.
 	DomainName string `json:"domainName"`
 
-	// IsAvailable is the registration state of the domain name.
-	IsAvailable *StringBool `json:"domainAvailability"`
+	// Availability is the registration state of the domain name.
+	Availability Availability `json:"domainAvailability"`
+
+	// IsAvailable is the registration state of the domain name, nil if it's neither AVAILABLE nor UNAVAILABLE.
+	//
+	// Deprecated: use Availability, which also represents unknown and unrecognized states.
+	IsAvailable *StringBool `json:"-"`
+
+	// Extra holds the fields of the API response unknown to the library.
+	// It's filled in the lenient and strict schema modes only, see SchemaMode.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Meta describes how and when the response was obtained. It's not a part of the API response.
+	Meta *ResponseMeta `json:"-"`
+
+	// present records the required fields found while decoding, nil if the response wasn't decoded.
+	present *presentFields
+}
+
+// presentFields records which required fields are present in the API response.
+type presentFields struct {
+	domainName   bool
+	availability bool
+}
+
+// domainInfo decodes DomainAvailabilityResponse recording which required fields are present,
+// so a literal UNKNOWN state can be told apart from a missing one. DomainAvailabilityResponse itself
+// has no UnmarshalJSON method, so it isn't promoted to the types embedding it.
+type domainInfo DomainAvailabilityResponse
+
+// UnmarshalJSON decodes the fields of DomainAvailabilityResponse and records the present required ones.
+func (r *domainInfo) UnmarshalJSON(data []byte) error {
+	v := struct {
+		*DomainAvailabilityResponse
+		DomainName   *string       `json:"domainName"`
+		Availability *Availability `json:"domainAvailability"`
+	}{DomainAvailabilityResponse: (*DomainAvailabilityResponse)(r)}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	r.present = &presentFields{}
+
+	if v.DomainName != nil {
+		r.DomainName = *v.DomainName
+		r.present.domainName = true
+	}
+
+	if v.Availability != nil {
+		r.Availability = *v.Availability
+		r.present.availability = true
+	}
+
+	return nil
+}
+
+// setIsAvailable fills the deprecated IsAvailable field in from Availability.
+func (r *DomainAvailabilityResponse) setIsAvailable() {
+	r.IsAvailable = nil
+
+	if r.Availability.IsAvailable() || r.Availability.IsUnavailable() {
+		isAvailable := StringBool(r.Availability.IsAvailable())
+		r.IsAvailable = &isAvailable
+	}
+}
+
+// clone returns the copy of the response which shares no memory with it.
+func (r *DomainAvailabilityResponse) clone() *DomainAvailabilityResponse {
+	c := *r
+
+	if r.IsAvailable != nil {
+		isAvailable := *r.IsAvailable
+		c.IsAvailable = &isAvailable
+	}
+
+	if r.Extra != nil {
+		c.Extra = make(map[string]json.RawMessage, len(r.Extra))
+		for key, value := range r.Extra {
+			c.Extra[key] = append(json.RawMessage(nil), value...)
+		}
+	}
+
+	if r.Meta != nil {
+		meta := *r.Meta
+		c.Meta = &meta
+	}
+
+	if r.present != nil {
+		present := *r.present
+		c.present = &present
+	}
+
+	return &c
 }
 
 // ErrorMessage is the error message.
@@ -52,6 +52,39 @@ func TestStringBool(t *testing.T) {
 	}
 }
 
+// TestDeprecatedIsAvailable tests the deprecated IsAvailable field is filled in alongside Availability.
+func TestDeprecatedIsAvailable(t *testing.T) {
+	tests := []struct {
+		name string
+		resp string
+		want *bool
+	}{
+		{name: "available", resp: `{"DomainInfo":{"domainAvailability":"AVAILABLE"}}`, want: newBool(true)},
+		{name: "unavailable lower case", resp: `{"DomainInfo":{"domainAvailability":"unavailable"}}`, want: newBool(false)},
+		{name: "other", resp: `{"DomainInfo":{"domainAvailability":"RESERVED"}}`},
+		{name: "missing", resp: `{"DomainInfo":{}}`},
+	}
+	for _, tt := range tests {
+		for _, mode := range []SchemaMode{SchemaIgnore, SchemaLenient} {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := parse([]byte(tt.resp), mode)
+				checkErr(t, err, "")
+
+				isAvailable := got.IsAvailable
+				if (isAvailable == nil) != (tt.want == nil) ||
+					isAvailable != nil && bool(*isAvailable) != *tt.want {
+					t.Errorf("IsAvailable = %v, want %v", isAvailable, tt.want)
+				}
+			})
+		}
+	}
+}
+
+// newBool returns the pointer to the value.
+func newBool(v bool) *bool {
+	return &v
+}
+
 // checkErr checks for an error.
 func checkErr(t *testing.T, err error, want string) {
 	if (err != nil || want != "") && (err == nil || err.Error() != want) {
@@ -5,9 +5,38 @@ import (
 	"strings"
 )
 
+// Check modes accepted by OptionMode.
+const (
+	// ModeDNSOnly is the fast check mode.
+	ModeDNSOnly = "DNS_ONLY"
+
+	// ModeDNSAndWHOIS is the slower but more accurate check mode.
+	ModeDNSAndWHOIS = "DNS_AND_WHOIS"
+)
+
+// Values of the query parameters applied by the API when they are omitted.
+const (
+	// DefaultMode is the check mode used without OptionMode.
+	DefaultMode = ModeDNSOnly
+
+	// DefaultCredits is the type of credits used without OptionCredits.
+	DefaultCredits = "WHOIS"
+)
+
 // Option adds parameters to the query.
 type Option func(v url.Values)
 
+// ModeAndCredits returns the effective check mode and type of credits of the options,
+// DefaultMode and DefaultCredits if the options don't set them.
+func ModeAndCredits(opts ...Option) (mode, credits string) {
+	q := url.Values{}
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	return modeAndCredits(q.Get)
+}
+
 var _ = []Option{
 	OptionOutputFormat("JSON"),
 	OptionMode("DNS_ONLY"),
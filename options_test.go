@@ -60,3 +60,24 @@ func TestOptions(t *testing.T) {
 		})
 	}
 }
+
+// TestModeAndCredits tests the effective mode and credits of options.
+func TestModeAndCredits(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		mode    string
+		credits string
+	}{
+		{"defaults", nil, DefaultMode, DefaultCredits},
+		{"mode", []Option{OptionMode("dns_and_whois")}, ModeDNSAndWHOIS, DefaultCredits},
+		{"both", []Option{OptionMode(ModeDNSAndWHOIS), OptionCredits("da")}, ModeDNSAndWHOIS, "DA"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if mode, credits := ModeAndCredits(tt.opts...); mode != tt.mode || credits != tt.credits {
+				t.Errorf("ModeAndCredits() = %v, %v, want %v, %v", mode, credits, tt.mode, tt.credits)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package domainavailability
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// defaultMaxResponseSize is the maximum response body size used when ClientParams.MaxResponseSize is zero.
+const defaultMaxResponseSize = 10 << 20
+
+// maxPooledBufferSize is the capacity above which buffers are not returned to the pool.
+const maxPooledBufferSize = 64 << 10
+
+// ResponseTooLargeError is returned when the response body exceeds ClientParams.MaxResponseSize.
+type ResponseTooLargeError struct {
+	// Limit is the maximum allowed body size in bytes.
+	Limit int64
+}
+
+// Error returns error message as a string.
+func (e *ResponseTooLargeError) Error() string {
+	return "response body exceeds the limit of " + strconv.FormatInt(e.Limit, 10) + " bytes"
+}
+
+// limitedReader reads from r until the limit and fails with ResponseTooLargeError if there is more to read.
+type limitedReader struct {
+	r         io.Reader
+	limit     int64
+	remaining int64
+}
+
+// newLimitedReader limits the reader, negative limit disables the limit.
+func newLimitedReader(r io.Reader, limit int64) io.Reader {
+	if limit < 0 {
+		return r
+	}
+
+	return &limitedReader{r: r, limit: limit, remaining: limit}
+}
+
+// Read reads from the underlying reader within the limit.
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if l.remaining <= 0 {
+		var probe [1]byte
+
+		n, err := l.r.Read(probe[:])
+		if n > 0 {
+			return 0, &ResponseTooLargeError{Limit: l.limit}
+		}
+
+		return 0, err
+	}
+
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+
+	return n, err
+}
+
+// bufferPool reuses the buffers the response bodies are read into.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// getBuffer returns an empty buffer from the pool.
+func getBuffer() *bytes.Buffer {
+	b, _ := bufferPool.Get().(*bytes.Buffer)
+	b.Reset()
+
+	return b
+}
+
+// putBuffer returns the buffer to the pool unless it grew too large to keep.
+func putBuffer(b *bytes.Buffer) {
+	if b.Cap() > maxPooledBufferSize {
+		return
+	}
+
+	bufferPool.Put(b)
+}
@@ -0,0 +1,137 @@
+package domainavailability
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// TestMaxResponseSize tests the response body size limit.
+func TestMaxResponseSize(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"AVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body := resp
+		if req.URL.Path == "/large" {
+			body += strings.Repeat(" ", 1024)
+		}
+
+		if req.URL.Path == "/chunked" {
+			_, _ = w.Write([]byte(body[:10]))
+			w.(http.Flusher).Flush()
+			body = body[10:] + strings.Repeat(" ", 1024)
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	newLimitedAPI := func(path string, opts ...ClientOption) *Client {
+		apiURL, err := url.Parse(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		apiURL.Path = path
+
+		opts = append(opts,
+			WithHTTPClient(server.Client()),
+			WithBaseURL(apiURL.String()),
+			WithMaxResponseSize(int64(len(resp))))
+
+		client, err := New(apiKey, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return client
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		opts    []ClientOption
+		wantErr bool
+	}{
+		{name: "within limit", path: "/ok"},
+		{name: "within limit streaming", path: "/ok", opts: []ClientOption{WithStreaming()}},
+		{name: "content length above limit", path: "/large", wantErr: true},
+		{name: "chunked above limit", path: "/chunked", wantErr: true},
+		{name: "chunked above limit streaming", path: "/chunked", opts: []ClientOption{WithStreaming()}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := newLimitedAPI(tt.path, tt.opts...).Get(context.Background(), "whoisxmlapi.com")
+
+			var tooLarge *ResponseTooLargeError
+			if errors.As(err, &tooLarge) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				if tooLarge.Limit != int64(len(resp)) {
+					t.Errorf("ResponseTooLargeError.Limit = %d, want %d", tooLarge.Limit, len(resp))
+				}
+
+				return
+			}
+
+			if !got.Availability.IsAvailable() {
+				t.Errorf("Get() = %s, want AVAILABLE", got.Availability)
+			}
+		})
+	}
+}
+
+// TestStreamResponses tests Get decoding the body while reading it.
+func TestStreamResponses(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"UNAVAILABLE","domainName":"whoisxmlapi.com"}}`
+
+	server := dummyServer(resp, `<?xml version="1.0" encoding="utf-8"?><>`,
+		`{"ErrorMessage":{"errorCode":"WHOIS_01","msg":"Test error message."}}`)
+	defer server.Close()
+
+	newStreamingAPI := func(path string) *Client {
+		apiURL, err := url.Parse(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		apiURL.Path = path
+
+		return NewClient(apiKey, ClientParams{
+			HTTPClient:                server.Client(),
+			DomainAvailabilityBaseURL: apiURL,
+			StreamResponses:           true,
+			CoalesceRequests:          true,
+		})
+	}
+
+	ctx := context.Background()
+
+	got, raw, err := newStreamingAPI(pathDomainAvailabilityResponseOK).Get(ctx, "whoisxmlapi.com")
+	checkErr(t, err, "")
+
+	if !got.Availability.IsUnavailable() || got.Meta == nil || raw.Body != nil {
+		t.Errorf("Get() = %+v, body %q, want UNAVAILABLE without the body", got, raw.Body)
+	}
+
+	_, _, err = newStreamingAPI(pathDomainAvailabilityResponseError).Get(ctx, "whoisxmlapi.com")
+	checkErr(t, err, "API error: [WHOIS_01] Test error message.")
+
+	_, _, err = newStreamingAPI(pathDomainAvailabilityResponseUnparsable).Get(ctx, "whoisxmlapi.com")
+	if err == nil || !strings.HasPrefix(err.Error(), "cannot parse response") {
+		t.Errorf("Get() error = %v, want parse error", err)
+	}
+
+	rawResp, err := newStreamingAPI(pathDomainAvailabilityResponseOK).GetRaw(ctx, "whoisxmlapi.com")
+	checkErr(t, err, "")
+
+	if string(rawResp.Body) != resp {
+		t.Errorf("GetRaw() body = %q, want %q", rawResp.Body, resp)
+	}
+}
@@ -0,0 +1,146 @@
+package domainavailability
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SchemaMode defines how Get treats the fields of DomainInfo the library doesn't know.
+type SchemaMode int
+
+const (
+	// SchemaIgnore drops unknown fields silently. It's the default.
+	SchemaIgnore SchemaMode = iota
+
+	// SchemaLenient preserves unknown fields in DomainAvailabilityResponse.Extra.
+	SchemaLenient
+
+	// SchemaStrict fails Get with UnknownFieldsError when the response has unknown fields.
+	SchemaStrict
+)
+
+// UnknownFieldsError is returned by Get in the strict schema mode when DomainInfo has unknown fields.
+type UnknownFieldsError struct {
+	// Fields are the sorted names of the unknown fields
+	Fields []string
+}
+
+// Error returns error message as a string.
+func (e *UnknownFieldsError) Error() string {
+	return "unknown fields in response: " + strings.Join(e.Fields, ", ")
+}
+
+// knownFields are the JSON names of DomainAvailabilityResponse fields.
+var knownFields = jsonFieldNames(reflect.TypeOf(DomainAvailabilityResponse{}))
+
+// jsonFieldNames returns the JSON names of the struct fields.
+func jsonFieldNames(t reflect.Type) []string {
+	var names []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// isKnownField reports whether the key is decoded into a DomainAvailabilityResponse field.
+// Keys are matched case-insensitively like encoding/json does.
+func isKnownField(key string) bool {
+	for _, name := range knownFields {
+		if strings.EqualFold(name, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rawAPIResponse is used for parsing Domain Availability API response keeping DomainInfo as is.
+type rawAPIResponse struct {
+	DomainInfo   json.RawMessage `json:"DomainInfo"`
+	ErrorMessage `json:"ErrorMessage"`
+}
+
+// UnmarshalJSON decodes Domain Availability API response recording the present DomainInfo fields.
+func (r *apiResponse) UnmarshalJSON(data []byte) error {
+	v := struct {
+		DomainInfo   *domainInfo   `json:"DomainInfo"`
+		ErrorMessage *ErrorMessage `json:"ErrorMessage"`
+	}{
+		DomainInfo:   (*domainInfo)(&r.DomainAvailabilityResponse),
+		ErrorMessage: &r.ErrorMessage,
+	}
+
+	return json.Unmarshal(data, &v)
+}
+
+// decodeResponse decodes Domain Availability API response collecting unknown fields unless the mode is SchemaIgnore.
+func decodeResponse(r io.Reader, mode SchemaMode) (*apiResponse, error) {
+	var response apiResponse
+
+	if mode == SchemaIgnore {
+		if err := json.NewDecoder(r).Decode(&response); err != nil {
+			return nil, err
+		}
+
+		response.setIsAvailable()
+
+		return &response, nil
+	}
+
+	var raw rawAPIResponse
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	response.ErrorMessage = raw.ErrorMessage
+
+	if len(raw.DomainInfo) == 0 || string(raw.DomainInfo) == "null" {
+		return &response, nil
+	}
+
+	if err := json.Unmarshal(raw.DomainInfo, (*domainInfo)(&response.DomainAvailabilityResponse)); err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw.DomainInfo, &fields); err != nil {
+		return nil, err
+	}
+
+	for key, value := range fields {
+		if isKnownField(key) {
+			continue
+		}
+
+		if response.Extra == nil {
+			response.Extra = make(map[string]json.RawMessage)
+		}
+
+		response.Extra[key] = value
+		response.unknownFields = append(response.unknownFields, key)
+	}
+
+	sort.Strings(response.unknownFields)
+	response.setIsAvailable()
+
+	return &response, nil
+}
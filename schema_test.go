@@ -0,0 +1,212 @@
+package domainavailability
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestSchemaMode tests unknown response fields are dropped, preserved or reported depending on the schema mode.
+func TestSchemaMode(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"AVAILABLE","domainName":"whoisxmlapi.com",` +
+		`"price":{"amount":12},"premium":false}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	apiURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantFields := []string{"premium", "price"}
+
+	tests := []struct {
+		name      string
+		mode      SchemaMode
+		stream    bool
+		wantExtra bool
+		wantErr   bool
+		wantCalls int
+	}{
+		{name: "ignore", mode: SchemaIgnore},
+		{name: "lenient", mode: SchemaLenient, wantExtra: true, wantCalls: 1},
+		{name: "lenient streaming", mode: SchemaLenient, stream: true, wantExtra: true, wantCalls: 1},
+		{name: "strict", mode: SchemaStrict, wantErr: true, wantCalls: 1},
+		{name: "strict streaming", mode: SchemaStrict, stream: true, wantErr: true, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+
+			client := NewClient(apiKey, ClientParams{
+				HTTPClient:                server.Client(),
+				DomainAvailabilityBaseURL: apiURL,
+				StreamResponses:           tt.stream,
+				SchemaMode:                tt.mode,
+				OnUnknownFields: func(domainName string, fields []string) {
+					calls++
+
+					if domainName != "whoisxmlapi.com" || !reflect.DeepEqual(fields, wantFields) {
+						t.Errorf("OnUnknownFields(%q, %v), want whoisxmlapi.com and %v", domainName, fields, wantFields)
+					}
+				},
+			})
+
+			got, _, err := client.Get(context.Background(), "whoisxmlapi.com")
+
+			if calls != tt.wantCalls {
+				t.Errorf("OnUnknownFields calls = %d, want %d", calls, tt.wantCalls)
+			}
+
+			var unknown *UnknownFieldsError
+			if errors.As(err, &unknown) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				if !reflect.DeepEqual(unknown.Fields, wantFields) {
+					t.Errorf("UnknownFieldsError.Fields = %v, want %v", unknown.Fields, wantFields)
+				}
+
+				return
+			}
+
+			if !got.Availability.IsAvailable() || got.DomainName != "whoisxmlapi.com" {
+				t.Errorf("Get() = %+v, want AVAILABLE whoisxmlapi.com", got)
+			}
+
+			if !tt.wantExtra {
+				if got.Extra != nil {
+					t.Errorf("Get() Extra = %v, want nil", got.Extra)
+				}
+
+				return
+			}
+
+			if len(got.Extra) != 2 || string(got.Extra["price"]) != `{"amount":12}` ||
+				string(got.Extra["premium"]) != "false" {
+				t.Errorf("Get() Extra = %s, want price and premium", got.Extra)
+			}
+		})
+	}
+}
+
+// TestSchemaModeKnownFields tests the response without unknown fields passes the strict mode.
+func TestSchemaModeKnownFields(t *testing.T) {
+	server := dummyServer(`{"DomainInfo":{"DomainAvailability":"UNAVAILABLE","domainName":"whoisxmlapi.com"}}`,
+		`<?xml version="1.0" encoding="utf-8"?><>`,
+		`{"ErrorMessage":{"errorCode":"WHOIS_01","msg":"Test error message."}}`)
+	defer server.Close()
+
+	newStrictAPI := func(path string) *Client {
+		apiURL, err := url.Parse(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		apiURL.Path = path
+
+		client, err := New(apiKey,
+			WithHTTPClient(server.Client()),
+			WithBaseURL(apiURL.String()),
+			WithSchemaMode(SchemaStrict))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return client
+	}
+
+	got, _, err := newStrictAPI(pathDomainAvailabilityResponseOK).Get(context.Background(), "whoisxmlapi.com")
+	checkErr(t, err, "")
+
+	if !got.Availability.IsUnavailable() || got.Extra != nil {
+		t.Errorf("Get() = %+v, want UNAVAILABLE without extra fields", got)
+	}
+
+	_, _, err = newStrictAPI(pathDomainAvailabilityResponseError).Get(context.Background(), "whoisxmlapi.com")
+	checkErr(t, err, "API error: [WHOIS_01] Test error message.")
+
+	if _, err := New(apiKey, WithSchemaMode(SchemaStrict+1)); err == nil {
+		t.Errorf("New() with unknown schema mode error = nil, want ArgError")
+	}
+}
+
+// TestSchemaModeCoalescedExtra tests coalesced calls get their own copies of Extra.
+func TestSchemaModeCoalescedExtra(t *testing.T) {
+	const resp = `{"DomainInfo":{"domainAvailability":"AVAILABLE","domainName":"whoisxmlapi.com","premium":false}}`
+
+	var hits int64
+
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt64(&hits, 1)
+		<-release
+		_, _ = w.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	apiURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewClient(apiKey, ClientParams{
+		HTTPClient:                server.Client(),
+		DomainAvailabilityBaseURL: apiURL,
+		CoalesceRequests:          true,
+		SchemaMode:                SchemaLenient,
+	})
+
+	results := make([]*DomainAvailabilityResponse, 2)
+	errs := make([]error, 2)
+
+	var wg sync.WaitGroup
+
+	for i := range results {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			results[i], _, errs[i] = client.Get(context.Background(), "whoisxmlapi.com")
+		}(i)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("call %d error = %v", i, err)
+		}
+	}
+
+	if got := atomic.LoadInt64(&hits); got != 1 {
+		t.Fatalf("API requests = %d, want 1", got)
+	}
+
+	results[0].Extra["premium"][0] = 'T'
+	results[0].Extra["price"] = []byte("12")
+	*results[0].IsAvailable = false
+
+	if got := string(results[1].Extra["premium"]); got != "false" || len(results[1].Extra) != 1 {
+		t.Errorf("Extra of the other call = %v, want only premium false", results[1].Extra)
+	}
+
+	if !*results[1].IsAvailable {
+		t.Errorf("IsAvailable of the other call = false, want true")
+	}
+}
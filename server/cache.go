@@ -0,0 +1,103 @@
+package server
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// cacheEntry is the cached API response.
+type cacheEntry struct {
+	key      string
+	response *domainavailability.DomainAvailabilityResponse
+	expires  time.Time
+}
+
+// cache is the response cache shared by all callers.
+// It keeps at most maxEntries responses evicting the least recently used ones.
+type cache struct {
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+
+	// lru holds the entries from the most to the least recently used
+	lru *list.List
+}
+
+// newCache creates cache keeping at most maxEntries responses for ttl. Zero or negative ttl disables caching.
+func newCache(ttl time.Duration, maxEntries int) *cache {
+	return &cache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// get returns the cached response for the key if it's not expired.
+func (c *cache) get(key string, now time.Time) (*domainavailability.DomainAvailabilityResponse, bool) {
+	if c.ttl <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*cacheEntry)
+	if now.After(e.expires) {
+		c.remove(el)
+
+		return nil, false
+	}
+
+	c.lru.MoveToFront(el)
+
+	return e.response, true
+}
+
+// put saves the response for the key evicting the least recently used entry if the cache is full.
+func (c *cache) put(key string, response *domainavailability.DomainAvailabilityResponse, now time.Time) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*cacheEntry)
+		e.response, e.expires = response, now.Add(c.ttl)
+		c.lru.MoveToFront(el)
+
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, response: response, expires: now.Add(c.ttl)})
+
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// len returns the number of cached entries including the expired ones not evicted yet.
+func (c *cache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// remove deletes the entry. The mutex must be held.
+func (c *cache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*cacheEntry).key)
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// metricsPrefix is the prefix of the exported metric names.
+const metricsPrefix = "domain_availability_server_"
+
+// metrics is the set of counters exported by the /metrics endpoint.
+type metrics struct {
+	mu       sync.Mutex
+	counters map[string]uint64
+}
+
+// newMetrics creates metrics with the counters known in advance set to zero.
+func newMetrics() *metrics {
+	m := &metrics{counters: make(map[string]uint64)}
+
+	for _, name := range []string{
+		"check_requests", "bulk_requests", "cache_hits", "cache_misses",
+		"upstream_requests", "upstream_errors", "rate_limited", "unauthorized",
+	} {
+		m.counters[name] = 0
+	}
+
+	return m
+}
+
+// inc increments the counter.
+func (m *metrics) inc(name string) {
+	m.mu.Lock()
+	m.counters[name]++
+	m.mu.Unlock()
+}
+
+// write writes the counters in the Prometheus text format.
+func (m *metrics) write(w io.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.counters))
+	for name := range m.counters {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		_, _ = fmt.Fprintf(w, "# TYPE %s%s_total counter\n%s%s_total %d\n",
+			metricsPrefix, name, metricsPrefix, name, m.counters[name])
+	}
+}
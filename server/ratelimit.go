@@ -0,0 +1,66 @@
+package server
+
+import (
+	"sync"
+	"time"
+)
+
+// bucket is the token bucket of a single caller.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is the per-caller token bucket rate limiter.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// newRateLimiter creates rateLimiter allowing rate requests per second with the burst.
+// Zero or negative rate disables limiting.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst <= 0 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// allow takes n tokens from the caller bucket and reports whether it was possible.
+func (l *rateLimiter) allow(caller string, n int, now time.Time) bool {
+	if l.rate <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[caller]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[caller] = b
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+
+	b.last = now
+
+	if b.tokens < float64(n) {
+		return false
+	}
+
+	b.tokens -= float64(n)
+
+	return true
+}
@@ -0,0 +1,416 @@
+// Package server exposes Domain Availability API checks as a REST service,
+// so that callers don't need the API key.
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+const (
+	defaultMaxBulk         = 100
+	defaultBulkWorkers     = 8
+	defaultMaxCacheEntries = 10000
+	anonymousCaller        = "anonymous"
+)
+
+// errRateLimited is returned by cachingService when the caller exceeded the rate limit.
+var errRateLimited = errors.New("rate limit exceeded")
+
+// Params is used to create Server. None of parameters are mandatory.
+type Params struct {
+	// Tokens maps caller tokens to caller names
+	// If it's empty then requests are not authenticated
+	Tokens map[string]string
+
+	// CacheTTL is the time the responses are cached and shared between callers
+	// If it's zero then caching is disabled
+	CacheTTL time.Duration
+
+	// MaxCacheEntries is the maximum number of cached responses, the least recently used are evicted
+	// If it's zero then 10000 is used
+	MaxCacheEntries int
+
+	// RateLimit is the number of API requests per second allowed for each caller,
+	// responses served from the cache are not counted
+	// If it's zero then rate limiting is disabled
+	RateLimit float64
+
+	// RateBurst is the maximum number of API requests a caller can make at once
+	// Domain names of a bulk request above the limit get the RATE_LIMITED error
+	RateBurst int
+
+	// MaxBulk is the maximum number of domain names in a bulk request
+	// If it's zero then 100 is used
+	MaxBulk int
+
+	// BulkWorkers is the number of concurrent API requests for a bulk request
+	// If it's zero then 8 is used
+	BulkWorkers int
+
+	// BulkLimiter adapts the concurrency of API requests of all bulk requests together
+	// If it's set then BulkWorkers is ignored
+	BulkLimiter *domainavailability.AdaptiveLimiter
+}
+
+// Server is the http.Handler serving the availability checks.
+type Server struct {
+	service domainavailability.DomainAvailabilityService
+	params  Params
+	cache   *cache
+	limiter *rateLimiter
+	metrics *metrics
+	mux     *http.ServeMux
+}
+
+var _ http.Handler = &Server{}
+
+// New creates Server backed by the service.
+func New(service domainavailability.DomainAvailabilityService, params Params) *Server {
+	if params.MaxBulk <= 0 {
+		params.MaxBulk = defaultMaxBulk
+	}
+
+	if params.BulkWorkers <= 0 {
+		params.BulkWorkers = defaultBulkWorkers
+	}
+
+	if params.MaxCacheEntries <= 0 {
+		params.MaxCacheEntries = defaultMaxCacheEntries
+	}
+
+	s := &Server{
+		service: service,
+		params:  params,
+		cache:   newCache(params.CacheTTL, params.MaxCacheEntries),
+		limiter: newRateLimiter(params.RateLimit, params.RateBurst),
+		metrics: newMetrics(),
+		mux:     http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("/check", s.authenticated(s.handleCheck))
+	s.mux.HandleFunc("/bulk", s.authenticated(s.handleBulk))
+	s.mux.HandleFunc("/health", s.handleHealth)
+	s.mux.HandleFunc("/metrics", s.handleMetrics)
+
+	return s
+}
+
+// ServeHTTP dispatches the request to the endpoint handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// callerKey is the context key of the caller name.
+type callerKey struct{}
+
+// authenticated checks the caller token and saves the caller name to the request context.
+func (s *Server) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		caller := anonymousCaller
+
+		if len(s.params.Tokens) > 0 {
+			var ok bool
+
+			caller, ok = s.caller(r)
+			if !ok {
+				s.metrics.inc("unauthorized")
+				writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "missing or invalid token")
+
+				return
+			}
+		}
+
+		next(w, r.WithContext(context.WithValue(r.Context(), callerKey{}, caller)))
+	}
+}
+
+// caller returns the name of the caller identified by the Authorization header.
+func (s *Server) caller(r *http.Request) (string, bool) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		return "", false
+	}
+
+	for t, name := range s.params.Tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// handleCheck serves GET /check?domain=&mode=&credits=.
+func (s *Server) handleCheck(w http.ResponseWriter, r *http.Request) {
+	s.metrics.inc("check_requests")
+
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "use GET")
+
+		return
+	}
+
+	q := r.URL.Query()
+
+	domainName := q.Get("domain")
+	if domainName == "" {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", `"domain" parameter is required`)
+
+		return
+	}
+
+	resp, _, err := cachingService{s, s.service}.Get(r.Context(), domainName, options(q.Get("mode"), q.Get("credits"))...)
+	if err != nil {
+		writeUpstreamError(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// bulkRequest is the body of POST /bulk.
+type bulkRequest struct {
+	Domains []string `json:"domains"`
+	Mode    string   `json:"mode,omitempty"`
+	Credits string   `json:"credits,omitempty"`
+}
+
+// bulkResult is the result of a single domain name in the POST /bulk response.
+type bulkResult struct {
+	DomainName   string                           `json:"domainName"`
+	Availability domainavailability.Availability  `json:"domainAvailability,omitempty"`
+	Error        *domainavailability.ErrorMessage `json:"ErrorMessage,omitempty"`
+}
+
+// bulkResponse is the POST /bulk response.
+type bulkResponse struct {
+	Results []bulkResult `json:"results"`
+}
+
+// handleBulk serves POST /bulk.
+func (s *Server) handleBulk(w http.ResponseWriter, r *http.Request) {
+	s.metrics.inc("bulk_requests")
+
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "use POST")
+
+		return
+	}
+
+	var req bulkRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "cannot parse request: "+err.Error())
+
+		return
+	}
+
+	if len(req.Domains) == 0 {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", `"domains" can not be empty`)
+
+		return
+	}
+
+	if len(req.Domains) > s.params.MaxBulk {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST",
+			fmt.Sprintf("too many domains: %d, maximum is %d", len(req.Domains), s.params.MaxBulk))
+
+		return
+	}
+
+	upstream, workers := s.service, s.params.BulkWorkers
+	if s.params.BulkLimiter != nil {
+		upstream, workers = domainavailability.Limited(s.service, s.params.BulkLimiter), s.params.BulkLimiter.MaxLimit()
+	}
+
+	results := domainavailability.GetBulk(r.Context(), cachingService{s, upstream}, req.Domains, workers,
+		options(req.Mode, req.Credits)...)
+
+	resp := bulkResponse{Results: make([]bulkResult, 0, len(results))}
+
+	for _, res := range results {
+		if res.Err != nil {
+			resp.Results = append(resp.Results, bulkResult{DomainName: res.DomainName, Error: toErrorMessage(res.Err)})
+
+			continue
+		}
+
+		resp.Results = append(resp.Results, bulkResult{DomainName: res.DomainName, Availability: res.Availability})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// options converts the mode and credits request parameters to the API options.
+func options(mode, credits string) []domainavailability.Option {
+	var opts []domainavailability.Option
+
+	if mode != "" {
+		opts = append(opts, domainavailability.OptionMode(mode))
+	}
+
+	if credits != "" {
+		opts = append(opts, domainavailability.OptionCredits(credits))
+	}
+
+	return opts
+}
+
+// cachingService is the DomainAvailabilityService wrapper sharing cached responses between callers.
+type cachingService struct {
+	server *Server
+
+	// service is called on cache misses
+	service domainavailability.DomainAvailabilityService
+}
+
+// Get returns the cached response or requests the API.
+func (c cachingService) Get(
+	ctx context.Context,
+	domainName string,
+	opts ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	s := c.server
+
+	q := url.Values{}
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	key := strings.ToLower(domainName) + "|" + q.Get("mode") + "|" + q.Get("credits")
+
+	if resp, ok := s.cache.get(key, time.Now()); ok {
+		s.metrics.inc("cache_hits")
+
+		cached := *resp
+		if resp.Meta != nil {
+			meta := *resp.Meta
+			meta.Source = domainavailability.SourceCache
+			cached.Meta = &meta
+		}
+
+		return &cached, nil, nil
+	}
+
+	s.metrics.inc("cache_misses")
+
+	if !s.allow(ctx) {
+		return nil, nil, errRateLimited
+	}
+
+	s.metrics.inc("upstream_requests")
+
+	resp, raw, err := c.service.Get(ctx, domainName, opts...)
+	if err != nil {
+		s.metrics.inc("upstream_errors")
+
+		return nil, raw, err
+	}
+
+	s.cache.put(key, resp, time.Now())
+
+	return resp, raw, nil
+}
+
+// GetRaw requests the API, raw responses are not cached.
+func (c cachingService) GetRaw(
+	ctx context.Context,
+	domainName string,
+	opts ...domainavailability.Option,
+) (*domainavailability.Response, error) {
+	return c.service.GetRaw(ctx, domainName, opts...)
+}
+
+// allow takes a token for an upstream request from the bucket of the caller saved to the context.
+func (s *Server) allow(ctx context.Context) bool {
+	caller, _ := ctx.Value(callerKey{}).(string)
+
+	if !s.limiter.allow(caller, 1, time.Now()) {
+		s.metrics.inc("rate_limited")
+
+		return false
+	}
+
+	return true
+}
+
+// handleHealth serves GET /health.
+func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleMetrics serves GET /metrics in the Prometheus text format.
+func (s *Server) handleMetrics(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	s.metrics.write(w)
+
+	_, _ = fmt.Fprintf(w, "# TYPE %scache_entries gauge\n%scache_entries %d\n",
+		metricsPrefix, metricsPrefix, s.cache.len())
+
+	if s.params.BulkLimiter != nil {
+		_, _ = fmt.Fprintf(w, "# TYPE %sbulk_concurrency_limit gauge\n%sbulk_concurrency_limit %d\n",
+			metricsPrefix, metricsPrefix, s.params.BulkLimiter.Limit())
+	}
+}
+
+// errorResponse mirrors the Domain Availability API error response.
+type errorResponse struct {
+	ErrorMessage *domainavailability.ErrorMessage `json:"ErrorMessage"`
+}
+
+// writeError writes the error response.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, errorResponse{&domainavailability.ErrorMessage{Code: code, Message: message}})
+}
+
+// writeUpstreamError writes the error returned by the service.
+func writeUpstreamError(w http.ResponseWriter, err error) {
+	status := http.StatusBadGateway
+
+	var argErr *domainavailability.ArgError
+
+	switch {
+	case errors.As(err, &argErr):
+		status = http.StatusBadRequest
+	case errors.Is(err, errRateLimited):
+		status = http.StatusTooManyRequests
+	}
+
+	writeJSON(w, status, errorResponse{toErrorMessage(err)})
+}
+
+// toErrorMessage converts the service error to ErrorMessage.
+func toErrorMessage(err error) *domainavailability.ErrorMessage {
+	var apiErr *domainavailability.ErrorMessage
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	var argErr *domainavailability.ArgError
+	if errors.As(err, &argErr) {
+		return &domainavailability.ErrorMessage{Code: "BAD_REQUEST", Message: argErr.Error()}
+	}
+
+	if errors.Is(err, errRateLimited) {
+		return &domainavailability.ErrorMessage{Code: "RATE_LIMITED", Message: err.Error()}
+	}
+
+	return &domainavailability.ErrorMessage{Code: "UPSTREAM_ERROR", Message: err.Error()}
+}
+
+// writeJSON writes v as the JSON response.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
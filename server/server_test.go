@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// countingService is the DomainAvailabilityService counting Get calls, "taken.com" is unavailable.
+type countingService struct {
+	calls int64
+}
+
+// Get returns the availability of the domain name.
+func (s *countingService) Get(
+	_ context.Context,
+	domainName string,
+	_ ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	atomic.AddInt64(&s.calls, 1)
+
+	if domainName == "error.com" {
+		return nil, nil, &domainavailability.ErrorMessage{Code: "WHOIS_00", Message: "Test error message."}
+	}
+
+	availability := domainavailability.Available
+	if domainName == "taken.com" {
+		availability = domainavailability.Unavailable
+	}
+
+	return &domainavailability.DomainAvailabilityResponse{DomainName: domainName, Availability: availability}, nil, nil
+}
+
+// GetRaw is not used by Server.
+func (s *countingService) GetRaw(
+	context.Context,
+	string,
+	...domainavailability.Option,
+) (*domainavailability.Response, error) {
+	return nil, nil
+}
+
+// TestServer tests the endpoints of Server.
+func TestServer(t *testing.T) {
+	service := &countingService{}
+
+	handler := New(service, Params{
+		Tokens:    map[string]string{"secret": "tester"},
+		CacheTTL:  time.Minute,
+		RateLimit: 1,
+		RateBurst: 4,
+
+		BulkLimiter: domainavailability.NewAdaptiveLimiter(domainavailability.LimiterParams{InitialLimit: 2}),
+	})
+
+	do := func(method, target, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	if rec := do(http.MethodGet, "/check?domain=taken.com", "", ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("GET /check without token status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	rec := do(http.MethodGet, "/check?domain=taken.com", "secret", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /check status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	if got, want := strings.TrimSpace(rec.Body.String()),
+		`{"domainName":"taken.com","domainAvailability":"UNAVAILABLE"}`; got != want {
+		t.Errorf("GET /check body = %s, want %s", got, want)
+	}
+
+	rec = do(http.MethodPost, "/bulk", "secret", `{"domains":["taken.com","free.com","error.com"]}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST /bulk status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var bulk bulkResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &bulk); err != nil {
+		t.Fatalf("POST /bulk body parse error = %v", err)
+	}
+
+	if len(bulk.Results) != 3 || !bulk.Results[1].Availability.IsAvailable() ||
+		bulk.Results[2].Error == nil || bulk.Results[2].Error.Code != "WHOIS_00" {
+		t.Errorf("POST /bulk body = %s", rec.Body.String())
+	}
+
+	if calls := atomic.LoadInt64(&service.calls); calls != 3 {
+		t.Errorf("service called %d times, want 3 as taken.com is cached", calls)
+	}
+
+	if rec = do(http.MethodGet, "/check?domain=other.com", "secret", ""); rec.Code != http.StatusOK {
+		t.Errorf("GET /check of the last token status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	if rec = do(http.MethodGet, "/check?domain=more.com", "secret", ""); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("GET /check over the limit status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+
+	if rec = do(http.MethodGet, "/check?domain=taken.com", "secret", ""); rec.Code != http.StatusOK {
+		t.Errorf("GET /check of a cached domain over the limit status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	rec = do(http.MethodPost, "/bulk", "secret", `{"domains":["taken.com","more.com"]}`)
+	if err := json.Unmarshal(rec.Body.Bytes(), &bulk); err != nil || rec.Code != http.StatusOK {
+		t.Fatalf("POST /bulk over the limit status = %d, body parse error = %v", rec.Code, err)
+	}
+
+	if !bulk.Results[0].Availability.IsUnavailable() ||
+		bulk.Results[1].Error == nil || bulk.Results[1].Error.Code != "RATE_LIMITED" {
+		t.Errorf("POST /bulk over the limit body = %s, want cached taken.com and rate limited more.com", rec.Body.String())
+	}
+
+	if rec = do(http.MethodGet, "/health", "", ""); rec.Code != http.StatusOK {
+		t.Errorf("GET /health status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	rec = do(http.MethodGet, "/metrics", "", "")
+	if !strings.Contains(rec.Body.String(), "domain_availability_server_cache_hits_total 3") ||
+		!strings.Contains(rec.Body.String(), "domain_availability_server_cache_entries 3") ||
+		!strings.Contains(rec.Body.String(), "domain_availability_server_bulk_concurrency_limit 2") {
+		t.Errorf("GET /metrics body = %s", rec.Body.String())
+	}
+}
+
+// TestCacheEviction tests the cache keeps at most the maximum number of entries evicting the least recently used.
+func TestCacheEviction(t *testing.T) {
+	c := newCache(time.Minute, 2)
+	now := time.Now()
+
+	resp := &domainavailability.DomainAvailabilityResponse{DomainName: "whoisxmlapi.com"}
+
+	c.put("a", resp, now)
+	c.put("b", resp, now)
+
+	if _, ok := c.get("a", now); !ok {
+		t.Fatalf("get(a) = false, want true")
+	}
+
+	c.put("c", resp, now)
+
+	if _, ok := c.get("b", now); ok {
+		t.Errorf("get(b) = true, want the least recently used entry evicted")
+	}
+
+	if _, ok := c.get("a", now); !ok {
+		t.Errorf("get(a) = false, want true")
+	}
+
+	if _, ok := c.get("c", now.Add(2*time.Minute)); ok {
+		t.Errorf("get(c) after ttl = true, want false")
+	}
+
+	if n := c.len(); n != 1 {
+		t.Errorf("len() = %d, want 1", n)
+	}
+}
@@ -0,0 +1,223 @@
+package store
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// entry is the position of a record in the file.
+type entry struct {
+	offset    int64
+	length    int
+	checkedAt time.Time
+}
+
+// ErrLocked is returned by OpenFile when the file is used by another FileStore, e.g. in another process.
+var ErrLocked = errors.New("store is locked by another process")
+
+// SkippedRecord is a line of the file which can't be parsed. It's skipped when the file is opened.
+type SkippedRecord struct {
+	// Line is the line number starting from 1
+	Line int
+
+	// Offset is the position of the line in the file
+	Offset int64
+
+	// Err is the parsing error
+	Err error
+}
+
+// FileStore is the append-only JSON Lines storage. The index of records by domain name is kept in memory
+// and rebuilt from the file on open.
+//
+// The file is exclusively locked while it's open, so only one FileStore at a time, in any process,
+// can use it. Locking isn't supported on platforms other than Windows and the common Unix systems.
+type FileStore struct {
+	mu      sync.RWMutex
+	file    *os.File
+	size    int64
+	index   map[string][]entry
+	skipped []SkippedRecord
+}
+
+var _ Store = &FileStore{}
+
+// OpenFile opens or creates the JSON Lines storage at the path. It fails with ErrLocked if the file is open
+// by another FileStore. Lines which can't be parsed are skipped and reported by SkippedRecords,
+// a partially written last line is truncated.
+func OpenFile(path string) (*FileStore, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open store: %w", err)
+	}
+
+	if err = lockFile(file); err != nil {
+		_ = file.Close()
+
+		return nil, fmt.Errorf("cannot lock store: %w", err)
+	}
+
+	s := &FileStore{
+		file:  file,
+		index: make(map[string][]entry),
+	}
+
+	if err = s.load(); err != nil {
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// SkippedRecords returns the lines skipped when the file was opened because they can't be parsed.
+func (s *FileStore) SkippedRecords() []SkippedRecord {
+	return s.skipped
+}
+
+// load builds the index from the file contents.
+func (s *FileStore) load() error {
+	r := bufio.NewReader(s.file)
+
+	var offset int64
+
+	for n := 1; ; n++ {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				// drop a partially written trailing record
+				if err = s.file.Truncate(offset); err != nil {
+					return fmt.Errorf("cannot truncate store: %w", err)
+				}
+			}
+
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("cannot read store: %w", err)
+		}
+
+		var rec Record
+		if err = json.Unmarshal(line, &rec); err != nil {
+			s.skipped = append(s.skipped, SkippedRecord{Line: n, Offset: offset, Err: err})
+		} else {
+			s.add(rec, offset, len(line))
+		}
+
+		offset += int64(len(line))
+	}
+
+	s.size = offset
+
+	return nil
+}
+
+// add adds the record position to the index.
+func (s *FileStore) add(rec Record, offset int64, length int) {
+	key := normalize(rec.DomainName)
+	s.index[key] = append(s.index[key], entry{offset: offset, length: length, checkedAt: rec.CheckedAt})
+}
+
+// Append saves the record.
+func (s *FileStore) Append(_ context.Context, rec Record) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err = s.file.WriteAt(line, s.size); err != nil {
+		return fmt.Errorf("cannot write store: %w", err)
+	}
+
+	s.add(rec, s.size, len(line))
+	s.size += int64(len(line))
+
+	return nil
+}
+
+// Latest returns the most recent record for the domain name or ErrNotFound.
+func (s *FileStore) Latest(_ context.Context, domainName string) (*Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := s.index[normalize(domainName)]
+	if len(entries) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return s.read(entries[len(entries)-1])
+}
+
+// History returns records for the domain name checked within [since, until) in the order they were saved.
+func (s *FileStore) History(ctx context.Context, domainName string, since, until time.Time) ([]Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var records []Record
+
+	for _, e := range s.index[normalize(domainName)] {
+		if !since.IsZero() && e.checkedAt.Before(since) {
+			continue
+		}
+
+		if !until.IsZero() && !e.checkedAt.Before(until) {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := s.read(e)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, *rec)
+	}
+
+	return records, nil
+}
+
+// read reads the record at the position.
+func (s *FileStore) read(e entry) (*Record, error) {
+	line := make([]byte, e.length)
+	if _, err := s.file.ReadAt(line, e.offset); err != nil {
+		return nil, fmt.Errorf("cannot read store: %w", err)
+	}
+
+	var rec Record
+	if err := json.Unmarshal(line, &rec); err != nil {
+		return nil, fmt.Errorf("cannot parse store record at offset %d: %w", e.offset, err)
+	}
+
+	return &rec, nil
+}
+
+// Close closes the file.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// normalize returns the index key for the domain name.
+func normalize(domainName string) string {
+	return strings.TrimSuffix(strings.ToLower(domainName), ".")
+}
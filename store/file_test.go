@@ -0,0 +1,142 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// TestFileStore tests saving and querying records including reopening the file.
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	s, err := OpenFile(path)
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	records := []Record{
+		{DomainName: "whoisxmlapi.com", CheckedAt: start, Mode: "DNS_ONLY", Availability: domainavailability.Unavailable},
+		{DomainName: "example.com", CheckedAt: start.Add(time.Hour), Mode: "DNS_ONLY", Availability: domainavailability.Available},
+		{DomainName: "WhoisXMLAPI.com.", CheckedAt: start.Add(2 * time.Hour), Mode: "DNS_AND_WHOIS", Availability: domainavailability.Available},
+	}
+
+	for _, rec := range records {
+		if err = s.Append(ctx, rec); err != nil {
+			t.Fatalf("FileStore.Append() error = %v", err)
+		}
+	}
+
+	if err = s.Close(); err != nil {
+		t.Fatalf("FileStore.Close() error = %v", err)
+	}
+
+	s, err = OpenFile(path)
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+	defer s.Close()
+
+	latest, err := s.Latest(ctx, "whoisxmlapi.com")
+	if err != nil {
+		t.Fatalf("FileStore.Latest() error = %v", err)
+	}
+
+	if latest.Mode != "DNS_AND_WHOIS" || !latest.Availability.IsAvailable() {
+		t.Errorf("FileStore.Latest() = %+v, want the third record", latest)
+	}
+
+	history, err := s.History(ctx, "whoisxmlapi.com", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("FileStore.History() error = %v", err)
+	}
+
+	if len(history) != 2 {
+		t.Errorf("FileStore.History() returned %d records, want 2", len(history))
+	}
+
+	history, err = s.History(ctx, "whoisxmlapi.com", start.Add(time.Minute), time.Time{})
+	if err != nil {
+		t.Fatalf("FileStore.History() error = %v", err)
+	}
+
+	if len(history) != 1 {
+		t.Errorf("FileStore.History() with since returned %d records, want 1", len(history))
+	}
+
+	if _, err = s.Latest(ctx, "unknown.com"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("FileStore.Latest() error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+// TestFileStoreCorrupt tests unparsable lines are skipped and reported and a partial last line is truncated.
+func TestFileStoreCorrupt(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	content := `{"domainName":"whoisxmlapi.com","checkedAt":"2022-01-01T00:00:00Z","availability":"UNAVAILABLE"}` + "\n" +
+		"garbage\n" +
+		`{"domainName":"whoisxmlapi.com","checkedAt":"2022-01-01T01:00:00Z","availability":"AVAILABLE"}` + "\n" +
+		`{"domainName":"whoisxmlapi.com","checkedAt":"2022-01-01T02:00`
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := OpenFile(path)
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+	defer s.Close()
+
+	skipped := s.SkippedRecords()
+	if len(skipped) != 1 || skipped[0].Line != 2 || skipped[0].Offset != 97 || skipped[0].Err == nil {
+		t.Errorf("FileStore.SkippedRecords() = %+v, want line 2 at offset 97", skipped)
+	}
+
+	if err = s.Append(ctx, Record{DomainName: "example.com", Availability: domainavailability.Available}); err != nil {
+		t.Fatalf("FileStore.Append() error = %v", err)
+	}
+
+	history, err := s.History(ctx, "whoisxmlapi.com", time.Time{}, time.Time{})
+	if err != nil || len(history) != 2 || !history[1].Availability.IsAvailable() {
+		t.Errorf("FileStore.History() = %+v, %v, want 2 records", history, err)
+	}
+
+	if latest, err := s.Latest(ctx, "example.com"); err != nil || !latest.Availability.IsAvailable() {
+		t.Errorf("FileStore.Latest() = %+v, %v, want the appended record", latest, err)
+	}
+}
+
+// TestFileStoreLocked tests the file can't be opened by another FileStore until it's closed.
+func TestFileStoreLocked(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	s, err := OpenFile(path)
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+
+	if _, err = OpenFile(path); !errors.Is(err, ErrLocked) {
+		t.Errorf("OpenFile() of the open file error = %v, want %v", err, ErrLocked)
+	}
+
+	if err = s.Close(); err != nil {
+		t.Fatalf("FileStore.Close() error = %v", err)
+	}
+
+	s, err = OpenFile(path)
+	if err != nil {
+		t.Fatalf("OpenFile() after Close error = %v", err)
+	}
+
+	_ = s.Close()
+}
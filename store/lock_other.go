@@ -0,0 +1,11 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+package store
+
+import "os"
+
+// lockFile does nothing as file locking isn't supported on this platform.
+func lockFile(*os.File) error {
+	return nil
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// recordingService is the DomainAvailabilityService wrapper saving every result to the store.
+type recordingService struct {
+	service domainavailability.DomainAvailabilityService
+	store   Store
+	onError func(err error)
+}
+
+// Recording wraps the service so that every Get and GetRaw result is saved to the store.
+// Errors of the store are passed to onError if it's not nil, the API results are returned unchanged.
+func Recording(
+	service domainavailability.DomainAvailabilityService,
+	store Store,
+	onError func(err error),
+) domainavailability.DomainAvailabilityService {
+	return &recordingService{
+		service: service,
+		store:   store,
+		onError: onError,
+	}
+}
+
+// Get calls the wrapped service and saves the result.
+func (r *recordingService) Get(
+	ctx context.Context,
+	domainName string,
+	opts ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	domainAvailabilityResp, resp, err := r.service.Get(ctx, domainName, opts...)
+
+	r.save(ctx, NewRecord(domainName, opts, domainAvailabilityResp, resp, err))
+
+	return domainAvailabilityResp, resp, err
+}
+
+// GetRaw calls the wrapped service and saves the result.
+func (r *recordingService) GetRaw(
+	ctx context.Context,
+	domainName string,
+	opts ...domainavailability.Option,
+) (*domainavailability.Response, error) {
+	resp, err := r.service.GetRaw(ctx, domainName, opts...)
+
+	r.save(ctx, NewRecord(domainName, opts, nil, resp, err))
+
+	return resp, err
+}
+
+// save appends the record to the store.
+func (r *recordingService) save(ctx context.Context, rec Record) {
+	if err := r.store.Append(ctx, rec); err != nil && r.onError != nil {
+		r.onError(err)
+	}
+}
@@ -0,0 +1,116 @@
+// Package store keeps the history of Domain Availability API results.
+package store
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// ErrNotFound is returned when there are no records for the domain name.
+var ErrNotFound = errors.New("no records found")
+
+// Record is a single Domain Availability API result.
+type Record struct {
+	// DomainName is the requested domain name.
+	DomainName string `json:"domainName"`
+
+	// CheckedAt is the time the result was received.
+	CheckedAt time.Time `json:"checkedAt"`
+
+	// Mode is the check mode: DNS_AND_WHOIS|DNS_ONLY.
+	Mode string `json:"mode"`
+
+	// Credits is the type of credits used: DA|WHOIS.
+	Credits string `json:"credits"`
+
+	// Availability is the registration state of the domain name.
+	Availability domainavailability.Availability `json:"availability"`
+
+	// StatusCode is the HTTP status code of the response, zero if the request failed.
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// Error is the error message if the request failed.
+	Error string `json:"error,omitempty"`
+
+	// RawBody is the raw API response body.
+	// It's empty when the client streams responses, see domainavailability.WithStreaming,
+	// as the body isn't kept then.
+	RawBody string `json:"rawBody,omitempty"`
+}
+
+// Store is an interface for the history storage.
+type Store interface {
+	// Append saves the record.
+	Append(ctx context.Context, rec Record) error
+
+	// Latest returns the most recent record for the domain name or ErrNotFound.
+	Latest(ctx context.Context, domainName string) (*Record, error)
+
+	// History returns records for the domain name checked within [since, until) in the order they were saved.
+	// Zero since or until means no bound.
+	History(ctx context.Context, domainName string, since, until time.Time) ([]Record, error)
+
+	// Close releases the resources held by the storage.
+	Close() error
+}
+
+// NewRecord builds Record from the arguments and the results of the Get or GetRaw call.
+// Mode and credits are taken from the request query or the options, the API defaults are used if they are absent.
+// RawBody is left empty for streamed responses whose Body is nil.
+func NewRecord(
+	domainName string,
+	opts []domainavailability.Option,
+	domainAvailabilityResp *domainavailability.DomainAvailabilityResponse,
+	resp *domainavailability.Response,
+	err error,
+) Record {
+	rec := Record{
+		DomainName: domainName,
+		CheckedAt:  time.Now().UTC(),
+		Mode:       domainavailability.DefaultMode,
+		Credits:    domainavailability.DefaultCredits,
+	}
+
+	q := url.Values{}
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	if resp != nil && resp.Response != nil && resp.Request != nil {
+		q = resp.Request.URL.Query()
+	}
+
+	if mode := q.Get("mode"); mode != "" {
+		rec.Mode = mode
+	}
+
+	if credits := q.Get("credits"); credits != "" {
+		rec.Credits = credits
+	}
+
+	if resp != nil {
+		rec.RawBody = string(resp.Body)
+
+		if resp.Response != nil {
+			rec.StatusCode = resp.StatusCode
+		}
+
+		if !resp.Meta.CheckedAt.IsZero() {
+			rec.CheckedAt = resp.Meta.CheckedAt
+		}
+	}
+
+	if domainAvailabilityResp != nil {
+		rec.Availability = domainAvailabilityResp.Availability
+	}
+
+	if err != nil {
+		rec.Error = err.Error()
+	}
+
+	return rec
+}
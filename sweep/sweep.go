@@ -0,0 +1,167 @@
+// Package sweep checks labels across many TLDs, e.g. whether "acme" is free in .com, .net and .io.
+package sweep
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// presets are the named TLD sets.
+var presets = map[string][]string{
+	"legacy": {"com", "net", "org", "info", "biz", "name", "pro", "mobi", "aero", "asia", "coop", "jobs", "tel", "travel"},
+	"cctld": {"us", "uk", "de", "fr", "it", "es", "nl", "be", "ch", "at", "se", "no", "dk", "fi", "pl", "cz",
+		"ru", "ua", "ca", "mx", "br", "ar", "au", "nz", "jp", "cn", "in", "kr", "sg", "za", "io", "co", "me", "tv"},
+	"new": {"app", "dev", "io", "ai", "xyz", "online", "site", "store", "tech", "shop", "blog", "cloud", "top",
+		"club", "live", "design", "agency", "digital", "space", "website", "page", "world", "news", "link"},
+	"popular": {"com", "net", "org", "io", "co", "ai", "app", "dev", "info", "xyz"},
+}
+
+// Presets returns the names of the TLD presets.
+func Presets() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Preset returns a copy of the named TLD set: legacy (gTLDs), cctld, new (new gTLDs) or popular.
+func Preset(name string) ([]string, bool) {
+	tlds, ok := presets[strings.ToLower(name)]
+	if !ok {
+		return nil, false
+	}
+
+	return append([]string(nil), tlds...), true
+}
+
+// ReadTLDs reads the TLD list, one per line. Blank lines, # comments and leading dots are skipped.
+func ReadTLDs(r io.Reader) ([]string, error) {
+	var tlds []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		if tld := normalizeTLD(line); tld != "" {
+			tlds = append(tlds, tld)
+		}
+	}
+
+	return tlds, scanner.Err()
+}
+
+// normalizeTLD returns the TLD in lower case without surrounding spaces and dots.
+func normalizeTLD(tld string) string {
+	return strings.Trim(strings.ToLower(strings.TrimSpace(tld)), ".")
+}
+
+// Matrix is the result of the sweep: a row per label and a column per TLD.
+type Matrix struct {
+	// Labels are the checked labels.
+	Labels []string
+
+	// TLDs are the checked TLDs.
+	TLDs []string
+
+	// Results are indexed by the label and the TLD positions.
+	Results [][]domainavailability.BulkResult
+}
+
+// Get returns the result for the label and the TLD.
+func (m *Matrix) Get(label, tld string) (domainavailability.BulkResult, bool) {
+	label, tld = strings.ToLower(label), normalizeTLD(tld)
+
+	for i, l := range m.Labels {
+		if l != label {
+			continue
+		}
+
+		for j, t := range m.TLDs {
+			if t == tld {
+				return m.Results[i][j], true
+			}
+		}
+	}
+
+	return domainavailability.BulkResult{}, false
+}
+
+// Available returns the domain names reported as available.
+func (m *Matrix) Available() []string {
+	var domainNames []string
+
+	for _, row := range m.Results {
+		for _, res := range row {
+			if res.Err == nil && res.DomainAvailabilityResponse != nil && res.Availability.IsAvailable() {
+				domainNames = append(domainNames, res.DomainName)
+			}
+		}
+	}
+
+	return domainNames
+}
+
+// Sweep checks every label in every TLD concurrently using at most workers simultaneous requests.
+func Sweep(
+	ctx context.Context,
+	service domainavailability.DomainAvailabilityService,
+	labels []string,
+	tlds []string,
+	workers int,
+	opts ...domainavailability.Option,
+) (*Matrix, error) {
+	m := &Matrix{}
+
+	for _, label := range labels {
+		if label = strings.ToLower(strings.TrimSpace(label)); label != "" {
+			m.Labels = append(m.Labels, label)
+		}
+	}
+
+	seen := make(map[string]bool)
+
+	for _, tld := range tlds {
+		if tld = normalizeTLD(tld); tld != "" && !seen[tld] {
+			seen[tld] = true
+			m.TLDs = append(m.TLDs, tld)
+		}
+	}
+
+	if len(m.Labels) == 0 {
+		return nil, errors.New("no labels to sweep")
+	}
+
+	if len(m.TLDs) == 0 {
+		return nil, errors.New("no TLDs to sweep")
+	}
+
+	domainNames := make([]string, 0, len(m.Labels)*len(m.TLDs))
+
+	for _, label := range m.Labels {
+		for _, tld := range m.TLDs {
+			domainNames = append(domainNames, label+"."+tld)
+		}
+	}
+
+	results := domainavailability.GetBulk(ctx, service, domainNames, workers, opts...)
+
+	m.Results = make([][]domainavailability.BulkResult, len(m.Labels))
+	for i := range m.Labels {
+		m.Results[i] = results[i*len(m.TLDs) : (i+1)*len(m.TLDs)]
+	}
+
+	return m, nil
+}
@@ -0,0 +1,65 @@
+package sweep
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// tldService is the DomainAvailabilityService where only .com names are registered.
+type tldService struct{}
+
+// Get returns UNAVAILABLE for .com names and AVAILABLE otherwise.
+func (tldService) Get(
+	_ context.Context,
+	domainName string,
+	_ ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	availability := domainavailability.Available
+	if strings.HasSuffix(domainName, ".com") {
+		availability = domainavailability.Unavailable
+	}
+
+	return &domainavailability.DomainAvailabilityResponse{DomainName: domainName, Availability: availability}, nil, nil
+}
+
+// GetRaw is not used by Sweep.
+func (tldService) GetRaw(context.Context, string, ...domainavailability.Option) (*domainavailability.Response, error) {
+	return nil, nil
+}
+
+// TestSweep tests the sweep matrix.
+func TestSweep(t *testing.T) {
+	tlds, err := ReadTLDs(strings.NewReader("# custom list\n.COM\nnet\n\nio # comment\nnet\n"))
+	if err != nil {
+		t.Fatalf("ReadTLDs() error = %v", err)
+	}
+
+	m, err := Sweep(context.Background(), tldService{}, []string{"Acme", "whoisxmlapi"}, tlds, 2)
+	if err != nil {
+		t.Fatalf("Sweep() error = %v", err)
+	}
+
+	if len(m.Labels) != 2 || len(m.TLDs) != 3 {
+		t.Fatalf("Sweep() = %d labels x %d TLDs, want 2 x 3", len(m.Labels), len(m.TLDs))
+	}
+
+	res, ok := m.Get("acme", ".com")
+	if !ok || res.DomainName != "acme.com" || !res.Availability.IsUnavailable() {
+		t.Errorf("Matrix.Get(acme, com) = %+v, %v, want UNAVAILABLE", res, ok)
+	}
+
+	if available := m.Available(); len(available) != 4 {
+		t.Errorf("Matrix.Available() = %v, want 4 names", available)
+	}
+
+	if _, err = Sweep(context.Background(), tldService{}, []string{"acme"}, nil, 2); err == nil {
+		t.Errorf("Sweep() without TLDs error = nil, want error")
+	}
+
+	if tlds, ok := Preset("LEGACY"); !ok || tlds[0] != "com" {
+		t.Errorf("Preset(LEGACY) = %v, %v", tlds, ok)
+	}
+}
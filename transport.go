@@ -0,0 +1,134 @@
+package domainavailability
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Default HTTP client parameters.
+const (
+	defaultTimeout               = 30 * time.Second
+	defaultDialTimeout           = 10 * time.Second
+	defaultKeepAlive             = 30 * time.Second
+	defaultTLSHandshakeTimeout   = 10 * time.Second
+	defaultResponseHeaderTimeout = 20 * time.Second
+	defaultIdleConnTimeout       = 90 * time.Second
+	defaultMaxIdleConns          = 100
+	defaultMaxIdleConnsPerHost   = 32
+)
+
+// TransportParams is used to create the default HTTP transport.
+// None of parameters are mandatory, zero values are replaced with the defaults.
+type TransportParams struct {
+	// DialTimeout is the maximum time to establish a TCP connection. Default: 10s.
+	DialTimeout time.Duration
+
+	// KeepAlive is the TCP keep-alive period. Default: 30s.
+	KeepAlive time.Duration
+
+	// TLSHandshakeTimeout is the maximum time to perform the TLS handshake. Default: 10s.
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout is the maximum time to wait for the response headers. Default: 20s.
+	ResponseHeaderTimeout time.Duration
+
+	// IdleConnTimeout is the time an idle connection is kept in the pool. Default: 90s.
+	IdleConnTimeout time.Duration
+
+	// MaxIdleConns is the size of the idle connection pool. Default: 100.
+	MaxIdleConns int
+
+	// MaxIdleConnsPerHost is the number of idle connections kept for the API host,
+	// it should be at least the bulk concurrency. Default: 32.
+	MaxIdleConnsPerHost int
+
+	// MaxConnsPerHost limits the number of connections to the API host. Default: no limit.
+	MaxConnsPerHost int
+
+	// DisableHTTP2 disables HTTP/2 which is attempted by default.
+	DisableHTTP2 bool
+
+	// Proxy returns the proxy for the request. Default: http.ProxyFromEnvironment.
+	Proxy func(*http.Request) (*url.URL, error)
+}
+
+// defaultTransport is shared by the HTTP clients created without transport parameters,
+// so their connections are pooled together.
+var defaultTransport = NewTransport(TransportParams{})
+
+// isZero reports whether no parameter is set.
+func (p TransportParams) isZero() bool {
+	return p.DialTimeout == 0 && p.KeepAlive == 0 && p.TLSHandshakeTimeout == 0 &&
+		p.ResponseHeaderTimeout == 0 && p.IdleConnTimeout == 0 &&
+		p.MaxIdleConns == 0 && p.MaxIdleConnsPerHost == 0 && p.MaxConnsPerHost == 0 &&
+		!p.DisableHTTP2 && p.Proxy == nil
+}
+
+// NewHTTPClient creates the HTTP client with the overall request timeout and the transport
+// built from params. Zero timeout means 30 seconds, negative timeout disables it.
+// If no transport parameter is set, the client uses the default transport shared by all such clients.
+func NewHTTPClient(timeout time.Duration, params TransportParams) *http.Client {
+	if timeout == 0 {
+		timeout = defaultTimeout
+	} else if timeout < 0 {
+		timeout = 0
+	}
+
+	transport := defaultTransport
+	if !params.isZero() {
+		transport = NewTransport(params)
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
+}
+
+// NewTransport creates the HTTP transport built from params.
+func NewTransport(params TransportParams) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   durationOr(params.DialTimeout, defaultDialTimeout),
+		KeepAlive: durationOr(params.KeepAlive, defaultKeepAlive),
+	}
+
+	proxy := params.Proxy
+	if proxy == nil {
+		proxy = http.ProxyFromEnvironment
+	}
+
+	return &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     !params.DisableHTTP2,
+		TLSClientConfig:       &tls.Config{MinVersion: tls.VersionTLS12},
+		TLSHandshakeTimeout:   durationOr(params.TLSHandshakeTimeout, defaultTLSHandshakeTimeout),
+		ResponseHeaderTimeout: durationOr(params.ResponseHeaderTimeout, defaultResponseHeaderTimeout),
+		IdleConnTimeout:       durationOr(params.IdleConnTimeout, defaultIdleConnTimeout),
+		ExpectContinueTimeout: time.Second,
+		MaxIdleConns:          intOr(params.MaxIdleConns, defaultMaxIdleConns),
+		MaxIdleConnsPerHost:   intOr(params.MaxIdleConnsPerHost, defaultMaxIdleConnsPerHost),
+		MaxConnsPerHost:       params.MaxConnsPerHost,
+	}
+}
+
+// durationOr returns d if it's positive and def otherwise.
+func durationOr(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+
+	return def
+}
+
+// intOr returns n if it's positive and def otherwise.
+func intOr(n, def int) int {
+	if n > 0 {
+		return n
+	}
+
+	return def
+}
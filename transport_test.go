@@ -0,0 +1,52 @@
+package domainavailability
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestNewHTTPClient tests the defaults and overrides of the default HTTP client.
+func TestNewHTTPClient(t *testing.T) {
+	c := NewHTTPClient(0, TransportParams{})
+	if c.Timeout != defaultTimeout {
+		t.Errorf("Timeout = %v, want %v", c.Timeout, defaultTimeout)
+	}
+
+	tr, ok := c.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", c.Transport)
+	}
+
+	if tr.ResponseHeaderTimeout != defaultResponseHeaderTimeout || tr.MaxIdleConnsPerHost != defaultMaxIdleConnsPerHost ||
+		!tr.ForceAttemptHTTP2 || tr.Proxy == nil {
+		t.Errorf("Transport = %+v, want defaults", tr)
+	}
+
+	if tr != defaultTransport || NewHTTPClient(time.Second, TransportParams{}).Transport != defaultTransport {
+		t.Errorf("Transport = %p, want the shared default transport %p", tr, defaultTransport)
+	}
+
+	c = NewHTTPClient(-1, TransportParams{
+		ResponseHeaderTimeout: time.Second,
+		MaxIdleConnsPerHost:   64,
+		DisableHTTP2:          true,
+	})
+	if c.Timeout != 0 {
+		t.Errorf("Timeout = %v, want no timeout", c.Timeout)
+	}
+
+	tr = c.Transport.(*http.Transport)
+	if tr.ResponseHeaderTimeout != time.Second || tr.MaxIdleConnsPerHost != 64 || tr.ForceAttemptHTTP2 {
+		t.Errorf("Transport = %+v, want overrides", tr)
+	}
+
+	if tr == defaultTransport {
+		t.Errorf("Transport is the shared default transport, want a new one")
+	}
+
+	client := NewClient(apiKey, ClientParams{Timeout: 5 * time.Second})
+	if client.client.Timeout != 5*time.Second {
+		t.Errorf("NewClient() HTTP client timeout = %v, want %v", client.client.Timeout, 5*time.Second)
+	}
+}
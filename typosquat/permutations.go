@@ -0,0 +1,284 @@
+// Package typosquat generates look-alike variants of domain names and finds the registered ones.
+package typosquat
+
+import (
+	"errors"
+	"strings"
+)
+
+// Kind is the permutation technique which produced the variant.
+type Kind string
+
+// Permutation techniques.
+const (
+	// Omission drops a character: example -> exmple.
+	Omission Kind = "omission"
+
+	// Insertion inserts a keyboard-adjacent character: example -> exsample.
+	Insertion Kind = "insertion"
+
+	// Transposition swaps adjacent characters: example -> exmaple.
+	Transposition Kind = "transposition"
+
+	// Replacement replaces a character with a keyboard-adjacent one: example -> exanple.
+	Replacement Kind = "replacement"
+
+	// Homoglyph replaces characters with similarly looking ASCII ones: example -> examp1e.
+	Homoglyph Kind = "homoglyph"
+
+	// BitFlip flips a bit of a character: example -> dxample.
+	BitFlip Kind = "bitflip"
+
+	// Hyphenation inserts a hyphen: example -> exam-ple.
+	Hyphenation Kind = "hyphenation"
+
+	// TLDSwap moves the name to another TLD: example.com -> example.net.
+	TLDSwap Kind = "tld-swap"
+
+	// Subdomain splits the name with a dot or glues a common prefix to it: example -> exa.mple, wwwexample.
+	Subdomain Kind = "subdomain"
+)
+
+// Kinds lists all permutation techniques in the order variants are generated.
+var Kinds = []Kind{
+	Omission, Insertion, Transposition, Replacement, Homoglyph, BitFlip, Hyphenation, TLDSwap, Subdomain,
+}
+
+// ParseKind returns the permutation technique with the name, e.g. "tld-swap".
+func ParseKind(name string) (Kind, error) {
+	kind := Kind(strings.ToLower(strings.TrimSpace(name)))
+	if !kind.valid() {
+		return "", errors.New("unknown permutation technique: " + name)
+	}
+
+	return kind, nil
+}
+
+// valid reports whether the kind is one of Kinds.
+func (k Kind) valid() bool {
+	for _, kind := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DefaultTLDs are the TLDs used by TLDSwap when Params.TLDs is empty.
+var DefaultTLDs = []string{"com", "net", "org", "info", "biz", "co", "io", "us", "cc", "xyz", "online", "site"}
+
+// Params is used to configure permutations. None of parameters are mandatory.
+type Params struct {
+	// Kinds are the permutation techniques to use
+	// If it's empty then all Kinds are used
+	Kinds []Kind
+
+	// TLDs are the TLDs used by TLDSwap
+	// If it's empty then DefaultTLDs are used
+	TLDs []string
+
+	// MaxVariants limits the number of variants, 0 means no limit
+	MaxVariants int
+}
+
+// Variant is a look-alike domain name.
+type Variant struct {
+	// DomainName is the look-alike domain name.
+	DomainName string
+
+	// Kind is the technique which produced the variant.
+	Kind Kind
+}
+
+// keyboard maps characters to their neighbours on the QWERTY keyboard.
+var keyboard = map[byte]string{
+	'1': "2q", '2': "13wq", '3': "24ew", '4': "35re", '5': "46tr", '6': "57yt", '7': "68uy", '8': "79iu", '9': "80oi",
+	'0': "9po", 'q': "12wa", 'w': "3qeas2", 'e': "4wrsd3", 'r': "5etdf4", 't': "6ryfg5", 'y': "7tugh6",
+	'u': "8yihj7", 'i': "9uojk8", 'o': "0ipkl9", 'p': "0ol", 'a': "qwsz", 's': "edxzaw", 'd': "rfcxse",
+	'f': "tgvcdr", 'g': "yhbvft", 'h': "ujnbgy", 'j': "ikmnhu", 'k': "olmji", 'l': "pko", 'z': "asx",
+	'x': "zsdc", 'c': "xdfv", 'v': "cfgb", 'b': "vghn", 'n': "bhjm", 'm': "njk",
+}
+
+// homoglyphs are the character sequences and their similarly looking ASCII replacements.
+var homoglyphs = []struct {
+	from string
+	to   []string
+}{
+	{"a", []string{"4"}}, {"b", []string{"6", "lb"}}, {"d", []string{"cl"}}, {"e", []string{"3"}},
+	{"g", []string{"9", "q"}}, {"i", []string{"1", "l"}}, {"l", []string{"1", "i"}}, {"m", []string{"rn", "nn"}},
+	{"n", []string{"r"}}, {"o", []string{"0"}}, {"q", []string{"g"}}, {"s", []string{"5"}}, {"t", []string{"7"}},
+	{"u", []string{"v"}}, {"w", []string{"vv"}}, {"z", []string{"2"}}, {"0", []string{"o"}},
+	{"1", []string{"l", "i"}}, {"rn", []string{"m"}}, {"cl", []string{"d"}}, {"vv", []string{"w"}},
+}
+
+// subdomainPrefixes are glued to the name by Subdomain.
+var subdomainPrefixes = []string{"www", "ww", "mail", "login", "secure"}
+
+// splitDomain splits the domain name into the first label and the suffix, e.g. "Example.co.uk." -> "example", "co.uk".
+// The leading "www." is stripped, so "www.example.com" is split into "example", "com". Other subdomains are not
+// recognized as the public suffix list is not used, so the domain name should be the registrable one.
+func splitDomain(domainName string) (name, suffix string, err error) {
+	domainName = strings.Trim(strings.ToLower(strings.TrimSpace(domainName)), ".")
+	if rest := strings.TrimPrefix(domainName, "www."); strings.Contains(rest, ".") {
+		domainName = rest
+	}
+
+	i := strings.IndexByte(domainName, '.')
+	if i <= 0 || i == len(domainName)-1 {
+		return "", "", errors.New("domain name must have a label and a TLD: " + domainName)
+	}
+
+	return domainName[:i], domainName[i+1:], nil
+}
+
+// Permutations generates the look-alike variants of the registrable domain name, e.g. "example.com".
+// The leading "www." is stripped. Variants are unique, valid host names and never equal to the domain name.
+// Unknown Params.Kinds fail with an error.
+func Permutations(domainName string, params Params) ([]Variant, error) {
+	name, suffix, err := splitDomain(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := params.Kinds
+	if len(kinds) == 0 {
+		kinds = Kinds
+	}
+
+	for _, kind := range kinds {
+		if !kind.valid() {
+			return nil, errors.New("unknown permutation technique: " + string(kind))
+		}
+	}
+
+	tlds := params.TLDs
+	if len(tlds) == 0 {
+		tlds = DefaultTLDs
+	}
+
+	g := generator{
+		original: name + "." + suffix,
+		seen:     make(map[string]bool),
+		max:      params.MaxVariants,
+	}
+
+	for _, kind := range kinds {
+		switch kind {
+		case Omission:
+			for i := range name {
+				g.add(kind, name[:i]+name[i+1:], suffix)
+			}
+		case Insertion:
+			for i := range name {
+				for _, c := range keyboard[name[i]] {
+					g.add(kind, name[:i]+string(c)+name[i:], suffix)
+					g.add(kind, name[:i+1]+string(c)+name[i+1:], suffix)
+				}
+			}
+		case Transposition:
+			for i := 0; i < len(name)-1; i++ {
+				g.add(kind, name[:i]+string(name[i+1])+string(name[i])+name[i+2:], suffix)
+			}
+		case Replacement:
+			for i := range name {
+				for _, c := range keyboard[name[i]] {
+					g.add(kind, name[:i]+string(c)+name[i+1:], suffix)
+				}
+			}
+		case Homoglyph:
+			for _, h := range homoglyphs {
+				for i := 0; i+len(h.from) <= len(name); i++ {
+					if name[i:i+len(h.from)] != h.from {
+						continue
+					}
+
+					for _, to := range h.to {
+						g.add(kind, name[:i]+to+name[i+len(h.from):], suffix)
+					}
+				}
+			}
+		case BitFlip:
+			for i := range name {
+				for bit := uint(0); bit < 8; bit++ {
+					if c := name[i] ^ (1 << bit); isHostChar(c) {
+						g.add(kind, name[:i]+string(c)+name[i+1:], suffix)
+					}
+				}
+			}
+		case Hyphenation:
+			for i := 1; i < len(name); i++ {
+				g.add(kind, name[:i]+"-"+name[i:], suffix)
+			}
+		case TLDSwap:
+			for _, tld := range tlds {
+				g.add(kind, name, strings.Trim(strings.ToLower(tld), "."))
+			}
+		case Subdomain:
+			for i := 1; i < len(name); i++ {
+				g.add(kind, name[:i]+"."+name[i:], suffix)
+			}
+
+			for _, prefix := range subdomainPrefixes {
+				g.add(kind, prefix+name, suffix)
+			}
+		}
+
+		if g.full() {
+			break
+		}
+	}
+
+	return g.variants, nil
+}
+
+// generator collects unique valid variants.
+type generator struct {
+	original string
+	seen     map[string]bool
+	max      int
+	variants []Variant
+}
+
+// add adds the variant if it's valid and new.
+func (g *generator) add(kind Kind, name, suffix string) {
+	if g.full() || name == "" || suffix == "" {
+		return
+	}
+
+	domainName := name + "." + suffix
+	if domainName == g.original || g.seen[domainName] || !isHostName(domainName) {
+		return
+	}
+
+	g.seen[domainName] = true
+	g.variants = append(g.variants, Variant{DomainName: domainName, Kind: kind})
+}
+
+// full reports whether the variant limit is reached.
+func (g *generator) full() bool {
+	return g.max > 0 && len(g.variants) >= g.max
+}
+
+// isHostChar reports whether c is allowed in host name labels.
+func isHostChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-'
+}
+
+// isHostName reports whether every label of the name is non-empty, consists of the host name
+// characters and neither starts nor ends with a hyphen.
+func isHostName(name string) bool {
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for i := 0; i < len(label); i++ {
+			if !isHostChar(label[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,70 @@
+package typosquat
+
+import (
+	"context"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// Threat is a registered look-alike domain name.
+type Threat struct {
+	Variant
+
+	// Response is the API response for the variant.
+	Response *domainavailability.DomainAvailabilityResponse
+}
+
+// Report is the result of the scan.
+type Report struct {
+	// DomainName is the protected domain name.
+	DomainName string
+
+	// Checked is the number of checked variants.
+	Checked int
+
+	// Threats are the registered variants in the generation order.
+	Threats []Threat
+
+	// Failed are the variants which could not be checked.
+	Failed []domainavailability.BulkResult
+}
+
+// Scan generates the variants of the domain name, checks them concurrently via the service
+// using at most workers simultaneous requests and reports the registered ones as threats.
+func Scan(
+	ctx context.Context,
+	service domainavailability.DomainAvailabilityService,
+	domainName string,
+	params Params,
+	workers int,
+	opts ...domainavailability.Option,
+) (*Report, error) {
+	variants, err := Permutations(domainName, params)
+	if err != nil {
+		return nil, err
+	}
+
+	domainNames := make([]string, len(variants))
+	for i, v := range variants {
+		domainNames[i] = v.DomainName
+	}
+
+	report := &Report{
+		DomainName: domainName,
+		Checked:    len(variants),
+	}
+
+	for i, res := range domainavailability.GetBulk(ctx, service, domainNames, workers, opts...) {
+		switch {
+		case res.Err != nil || res.DomainAvailabilityResponse == nil:
+			report.Failed = append(report.Failed, res)
+		case res.Availability.IsUnavailable():
+			report.Threats = append(report.Threats, Threat{
+				Variant:  variants[i],
+				Response: res.DomainAvailabilityResponse,
+			})
+		}
+	}
+
+	return report, nil
+}
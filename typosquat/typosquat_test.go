@@ -0,0 +1,219 @@
+package typosquat
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// TestPermutations tests the variants produced by each technique.
+func TestPermutations(t *testing.T) {
+	tests := []struct {
+		name   string
+		domain string
+		kind   Kind
+		want   []string
+		absent []string
+	}{
+		{
+			name:   "omission",
+			domain: "abc.com",
+			kind:   Omission,
+			want:   []string{"bc.com", "ac.com", "ab.com"},
+		},
+		{
+			name:   "transposition",
+			domain: "abc.com",
+			kind:   Transposition,
+			want:   []string{"bac.com", "acb.com"},
+		},
+		{
+			name:   "replacement",
+			domain: "example.com",
+			kind:   Replacement,
+			want:   []string{"exanple.com", "wxample.com"},
+		},
+		{
+			name:   "insertion",
+			domain: "example.com",
+			kind:   Insertion,
+			want:   []string{"exsample.com", "examplle.com"},
+		},
+		{
+			name:   "homoglyph",
+			domain: "modern.com",
+			kind:   Homoglyph,
+			want:   []string{"rnodern.com", "m0dern.com", "modem.com"},
+		},
+		{
+			name:   "bitflip",
+			domain: "example.com",
+			kind:   BitFlip,
+			want:   []string{"dxample.com"},
+			absent: []string{"Example.com"},
+		},
+		{
+			name:   "hyphenation",
+			domain: "abc.com",
+			kind:   Hyphenation,
+			want:   []string{"a-bc.com", "ab-c.com"},
+			absent: []string{"-abc.com", "abc-.com"},
+		},
+		{
+			name:   "tld swap",
+			domain: "Example.COM.",
+			kind:   TLDSwap,
+			want:   []string{"example.net", "example.io"},
+			absent: []string{"example.com"},
+		},
+		{
+			name:   "subdomain",
+			domain: "abc.co.uk",
+			kind:   Subdomain,
+			want:   []string{"a.bc.co.uk", "ab.c.co.uk", "wwwabc.co.uk"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			variants, err := Permutations(tt.domain, Params{Kinds: []Kind{tt.kind}})
+			if err != nil {
+				t.Fatalf("Permutations() error = %v", err)
+			}
+
+			got := make(map[string]bool)
+
+			for _, v := range variants {
+				if v.Kind != tt.kind {
+					t.Errorf("Permutations() kind = %s, want %s", v.Kind, tt.kind)
+				}
+
+				if got[v.DomainName] {
+					t.Errorf("Permutations() duplicate %s", v.DomainName)
+				}
+
+				got[v.DomainName] = true
+			}
+
+			for _, name := range tt.want {
+				if !got[name] {
+					t.Errorf("Permutations() missing %s in %v", name, variants)
+				}
+			}
+
+			for _, name := range tt.absent {
+				if got[name] {
+					t.Errorf("Permutations() unexpected %s", name)
+				}
+			}
+		})
+	}
+
+	variants, err := Permutations("example.com", Params{MaxVariants: 10})
+	if err != nil || len(variants) != 10 {
+		t.Errorf("Permutations() with MaxVariants = %d variants, %v, want 10", len(variants), err)
+	}
+
+	if _, err = Permutations("localhost", Params{}); err == nil {
+		t.Errorf("Permutations() without TLD error = nil, want error")
+	}
+
+	if _, err = Permutations("example.com", Params{Kinds: []Kind{"typo"}}); err == nil {
+		t.Errorf("Permutations() with unknown kind error = nil, want error")
+	}
+}
+
+// TestPermutationsWWW tests the leading "www." is stripped.
+func TestPermutationsWWW(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+		absent string
+	}{
+		{domain: "www.example.com", want: "exmple.com", absent: "ww.example.com"},
+		{domain: "WWW.Example.co.uk.", want: "exmple.co.uk", absent: "ww.example.co.uk"},
+		{domain: "www.com", want: "ww.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			variants, err := Permutations(tt.domain, Params{Kinds: []Kind{Omission}})
+			if err != nil {
+				t.Fatalf("Permutations() error = %v", err)
+			}
+
+			got := make(map[string]bool)
+			for _, v := range variants {
+				got[v.DomainName] = true
+			}
+
+			if !got[tt.want] || got[tt.absent] {
+				t.Errorf("Permutations() = %v, want %s and no %s", variants, tt.want, tt.absent)
+			}
+		})
+	}
+}
+
+// TestParseKind tests parsing of the permutation technique names.
+func TestParseKind(t *testing.T) {
+	if kind, err := ParseKind(" TLD-Swap "); err != nil || kind != TLDSwap {
+		t.Errorf("ParseKind() = %q, %v, want %q", kind, err, TLDSwap)
+	}
+
+	if _, err := ParseKind("typo"); err == nil {
+		t.Errorf("ParseKind() of unknown name error = nil, want error")
+	}
+}
+
+// registeredService is the DomainAvailabilityService where the listed names are registered.
+type registeredService map[string]bool
+
+// Get returns UNAVAILABLE for the registered names and fails for "fail.com".
+func (s registeredService) Get(
+	_ context.Context,
+	domainName string,
+	_ ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	if domainName == "exampl.com" {
+		return nil, nil, errors.New("check failed")
+	}
+
+	availability := domainavailability.Available
+	if s[domainName] {
+		availability = domainavailability.Unavailable
+	}
+
+	return &domainavailability.DomainAvailabilityResponse{DomainName: domainName, Availability: availability}, nil, nil
+}
+
+// GetRaw is not used by Scan.
+func (registeredService) GetRaw(context.Context, string, ...domainavailability.Option) (*domainavailability.Response, error) {
+	return nil, nil
+}
+
+// TestScan tests reporting of registered variants.
+func TestScan(t *testing.T) {
+	service := registeredService{"exmaple.com": true, "example.net": true}
+
+	report, err := Scan(context.Background(), service, "example.com",
+		Params{Kinds: []Kind{Omission, Transposition, TLDSwap}}, 4)
+	if err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	if len(report.Threats) != 2 {
+		t.Fatalf("Scan() threats = %+v, want 2", report.Threats)
+	}
+
+	if th := report.Threats[0]; th.DomainName != "exmaple.com" || th.Kind != Transposition {
+		t.Errorf("Scan() first threat = %s %s, want exmaple.com transposition", th.DomainName, th.Kind)
+	}
+
+	if th := report.Threats[1]; th.DomainName != "example.net" || th.Kind != TLDSwap {
+		t.Errorf("Scan() second threat = %s %s, want example.net tld-swap", th.DomainName, th.Kind)
+	}
+
+	if len(report.Failed) != 1 || report.Failed[0].DomainName != "exampl.com" {
+		t.Errorf("Scan() failed = %+v, want exampl.com", report.Failed)
+	}
+}
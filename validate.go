@@ -0,0 +1,70 @@
+package domainavailability
+
+import (
+	"strings"
+
+	"github.com/whois-api-llc/domain-availability-go/internal/punycode"
+)
+
+// DomainMismatchError is returned when the domain name in the response doesn't match the requested one.
+type DomainMismatchError struct {
+	// Requested is the domain name passed to Get
+	Requested string
+
+	// Returned is the domain name in the response
+	Returned string
+}
+
+// Error returns error message as a string.
+func (e *DomainMismatchError) Error() string {
+	return `response domain name "` + e.Returned + `" doesn't match requested "` + e.Requested + `"`
+}
+
+// MissingFieldError is returned when the response lacks a required field.
+type MissingFieldError struct {
+	// Field is the JSON name of the missing field
+	Field string
+}
+
+// Error returns error message as a string.
+func (e *MissingFieldError) Error() string {
+	return "response is missing required field " + e.Field
+}
+
+// ValidateResponse checks that the response has the required fields and is for the requested domain name.
+// The names are compared case-insensitively, ignoring the trailing dot and
+// treating the Unicode and punycode forms of internationalized names as equal.
+// For decoded responses the presence of the fields in the JSON is checked, so an explicit UNKNOWN
+// state is valid while an absent or null one is missing; for responses built in code the empty fields are missing.
+func ValidateResponse(domainName string, resp *DomainAvailabilityResponse) error {
+	hasDomainName, hasAvailability := resp.DomainName != "", !resp.Availability.IsUnknown()
+	if resp.present != nil {
+		hasDomainName, hasAvailability = resp.present.domainName, resp.present.availability
+	}
+
+	if !hasDomainName {
+		return &MissingFieldError{Field: "domainName"}
+	}
+
+	if !hasAvailability {
+		return &MissingFieldError{Field: "domainAvailability"}
+	}
+
+	if canonicalName(domainName) != canonicalName(resp.DomainName) {
+		return &DomainMismatchError{Requested: domainName, Returned: resp.DomainName}
+	}
+
+	return nil
+}
+
+// canonicalName returns the lower case ASCII form of the domain name without the trailing dot.
+// Names that can't be encoded to punycode are only lower cased.
+func canonicalName(domainName string) string {
+	name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domainName)), ".")
+
+	if ascii, err := punycode.ToASCII(name); err == nil {
+		return ascii
+	}
+
+	return name
+}
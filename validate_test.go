@@ -0,0 +1,171 @@
+package domainavailability
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+)
+
+// TestValidateResponse tests the echoed domain name comparison and the required fields check.
+func TestValidateResponse(t *testing.T) {
+	tests := []struct {
+		name         string
+		domainName   string
+		resp         DomainAvailabilityResponse
+		wantMismatch bool
+		wantMissing  string
+	}{
+		{
+			name:       "same",
+			domainName: "whoisxmlapi.com",
+			resp:       DomainAvailabilityResponse{DomainName: "whoisxmlapi.com", Availability: Unavailable},
+		},
+		{
+			name:       "case and trailing dot",
+			domainName: "WhoisXMLAPI.com.",
+			resp:       DomainAvailabilityResponse{DomainName: "whoisxmlapi.COM", Availability: Available},
+		},
+		{
+			name:       "unicode request punycode response",
+			domainName: "Bücher.example",
+			resp:       DomainAvailabilityResponse{DomainName: "xn--bcher-kva.example", Availability: Available},
+		},
+		{
+			name:       "punycode request unicode response",
+			domainName: "XN--BCHER-KVA.example",
+			resp:       DomainAvailabilityResponse{DomainName: "bücher.example.", Availability: Available},
+		},
+		{
+			name:         "other domain",
+			domainName:   "whoisxmlapi.com",
+			resp:         DomainAvailabilityResponse{DomainName: "whoisxmlapi.net", Availability: Available},
+			wantMismatch: true,
+		},
+		{
+			name:        "missing domain name",
+			domainName:  "whoisxmlapi.com",
+			resp:        DomainAvailabilityResponse{Availability: Available},
+			wantMissing: "domainName",
+		},
+		{
+			name:        "missing availability",
+			domainName:  "whoisxmlapi.com",
+			resp:        DomainAvailabilityResponse{DomainName: "whoisxmlapi.com"},
+			wantMissing: "domainAvailability",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateResponse(tt.domainName, &tt.resp)
+
+			var mismatch *DomainMismatchError
+			if errors.As(err, &mismatch) != tt.wantMismatch {
+				t.Fatalf("ValidateResponse() error = %v, wantMismatch %v", err, tt.wantMismatch)
+			}
+
+			if tt.wantMismatch && (mismatch.Requested != tt.domainName || mismatch.Returned != tt.resp.DomainName) {
+				t.Errorf("DomainMismatchError = %+v, want requested %q and returned %q",
+					mismatch, tt.domainName, tt.resp.DomainName)
+			}
+
+			var missing *MissingFieldError
+			if errors.As(err, &missing) != (tt.wantMissing != "") {
+				t.Fatalf("ValidateResponse() error = %v, want missing %q", err, tt.wantMissing)
+			}
+
+			if tt.wantMissing != "" && missing.Field != tt.wantMissing {
+				t.Errorf("MissingFieldError.Field = %q, want %q", missing.Field, tt.wantMissing)
+			}
+		})
+	}
+}
+
+// TestValidateDecodedResponse tests the required fields of decoded responses are checked by their presence.
+func TestValidateDecodedResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		domainInfo  string
+		wantMissing string
+	}{
+		{name: "literal unknown", domainInfo: `{"domainName":"whoisxmlapi.com","domainAvailability":"UNKNOWN"}`},
+		{
+			name:        "null state",
+			domainInfo:  `{"domainName":"whoisxmlapi.com","domainAvailability":null}`,
+			wantMissing: "domainAvailability",
+		},
+		{name: "empty state", domainInfo: `{"domainName":"whoisxmlapi.com","domainAvailability":""}`},
+		{
+			name:        "missing state",
+			domainInfo:  `{"domainName":"whoisxmlapi.com"}`,
+			wantMissing: "domainAvailability",
+		},
+		{
+			name:        "missing domain name",
+			domainInfo:  `{"domainAvailability":"AVAILABLE"}`,
+			wantMissing: "domainName",
+		},
+	}
+	for _, tt := range tests {
+		for _, mode := range []SchemaMode{SchemaIgnore, SchemaLenient} {
+			t.Run(tt.name, func(t *testing.T) {
+				resp, err := parse([]byte(`{"DomainInfo":`+tt.domainInfo+`}`), mode)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = ValidateResponse("whoisxmlapi.com", &resp.DomainAvailabilityResponse)
+
+				var missing *MissingFieldError
+				if errors.As(err, &missing) != (tt.wantMissing != "") || err != nil && tt.wantMissing == "" {
+					t.Fatalf("ValidateResponse() in mode %d error = %v, want missing %q", mode, err, tt.wantMissing)
+				}
+
+				if tt.wantMissing != "" && missing.Field != tt.wantMissing {
+					t.Errorf("MissingFieldError.Field = %q, want %q", missing.Field, tt.wantMissing)
+				}
+			})
+		}
+	}
+}
+
+// TestResponseValidation tests Get rejects the response for another domain name when the validation is enabled.
+func TestResponseValidation(t *testing.T) {
+	server := dummyServer(`{"DomainInfo":{"domainAvailability":"AVAILABLE","domainName":"whoisxmlapi.net"}}`,
+		`<?xml version="1.0" encoding="utf-8"?><>`,
+		`{"ErrorMessage":{"errorCode":"WHOIS_01","msg":"Test error message."}}`)
+	defer server.Close()
+
+	apiURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiURL.Path = pathDomainAvailabilityResponseOK
+
+	newValidatingAPI := func(opts ...ClientOption) *Client {
+		opts = append(opts, WithHTTPClient(server.Client()), WithBaseURL(apiURL.String()))
+
+		client, err := New(apiKey, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return client
+	}
+
+	ctx := context.Background()
+
+	_, _, err = newValidatingAPI(WithResponseValidation()).Get(ctx, "whoisxmlapi.com")
+	checkErr(t, err, `response domain name "whoisxmlapi.net" doesn't match requested "whoisxmlapi.com"`)
+
+	got, _, err := newValidatingAPI(WithResponseValidation()).Get(ctx, "WHOISXMLAPI.net.")
+	checkErr(t, err, "")
+
+	if !got.Availability.IsAvailable() {
+		t.Errorf("Get() = %s, want AVAILABLE", got.Availability)
+	}
+
+	_, _, err = newValidatingAPI().Get(ctx, "whoisxmlapi.com")
+	checkErr(t, err, "")
+}
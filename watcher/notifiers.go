@@ -0,0 +1,109 @@
+package watcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+// Notifier is an interface for change event receivers.
+type Notifier interface {
+	// Notify delivers the change event.
+	Notify(ctx context.Context, event Event) error
+}
+
+// NotifierFunc is an adapter to allow the use of ordinary functions as Notifier.
+type NotifierFunc func(ctx context.Context, event Event) error
+
+// Notify calls f(ctx, event).
+func (f NotifierFunc) Notify(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+var (
+	_ Notifier = NotifierFunc(nil)
+	_ Notifier = &WebhookNotifier{}
+	_ Notifier = &WriterNotifier{}
+)
+
+// WebhookNotifier sends change events as JSON in the body of POST requests.
+type WebhookNotifier struct {
+	// URL is the webhook endpoint
+	URL string
+
+	// HTTPClient is the client used to access the webhook
+	// If it's nil then http.DefaultClient is used
+	HTTPClient *http.Client
+
+	// Header is added to every request
+	Header http.Header
+}
+
+// Notify posts the change event to the webhook.
+func (n *WebhookNotifier) Notify(ctx context.Context, event Event) (err error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	for k, v := range n.Header {
+		req.Header[k] = v
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	httpClient := http.DefaultClient
+	if n.HTTPClient != nil {
+		httpClient = n.HTTPClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("cannot execute webhook request: %w", err)
+	}
+
+	defer func() {
+		if rerr := resp.Body.Close(); err == nil && rerr != nil {
+			err = fmt.Errorf("cannot close webhook response: %w", rerr)
+		}
+	}()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if c := resp.StatusCode; c < 200 || c > 299 {
+		return fmt.Errorf("webhook failed with status code: %s", strconv.Itoa(c))
+	}
+
+	return nil
+}
+
+// WriterNotifier writes change events as text lines to the writer.
+type WriterNotifier struct {
+	W io.Writer
+}
+
+// NewStdoutNotifier creates WriterNotifier writing to the standard output.
+func NewStdoutNotifier() *WriterNotifier {
+	return &WriterNotifier{W: os.Stdout}
+}
+
+// Notify writes the change event.
+func (n *WriterNotifier) Notify(_ context.Context, event Event) error {
+	_, err := fmt.Fprintf(n.W, "%s %s: %s -> %s\n",
+		event.CheckedAt.Format("2006-01-02T15:04:05Z07:00"),
+		event.DomainName,
+		event.Previous,
+		event.Current)
+
+	return err
+}
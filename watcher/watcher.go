@@ -0,0 +1,280 @@
+// Package watcher periodically re-checks a watchlist of domain names
+// and reports changes of their registration state.
+package watcher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// defaultInterval is the check interval used when neither the domain nor Params specify one.
+const defaultInterval = 10 * time.Minute
+
+// defaultEventBuffer is the default capacity of the events channel.
+const defaultEventBuffer = 64
+
+// Event is emitted when the registration state of a watched domain name changes.
+type Event struct {
+	// DomainName is the watched domain name.
+	DomainName string `json:"domainName"`
+
+	// Previous is the last known registration state.
+	Previous domainavailability.Availability `json:"previous"`
+
+	// Current is the current registration state.
+	Current domainavailability.Availability `json:"current"`
+
+	// CheckedAt is the time of the check which detected the change.
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// Params is used to create Watcher. None of parameters are mandatory.
+type Params struct {
+	// Notifiers receive every change event
+	Notifiers []Notifier
+
+	// Interval is the check interval for domains added without their own interval
+	// If it's zero then 10 minutes is used
+	Interval time.Duration
+
+	// EventBuffer is the capacity of the channel returned by Events
+	// If it's zero then 64 is used
+	EventBuffer int
+
+	// OnError is called when a check or a notifier fails
+	OnError func(domainName string, err error)
+}
+
+// target is a watched domain name.
+type target struct {
+	domainName string
+	interval   time.Duration
+	opts       []domainavailability.Option
+	cancel     context.CancelFunc
+}
+
+// Watcher re-checks the watchlist via DomainAvailabilityService.Get and emits change events.
+type Watcher struct {
+	service domainavailability.DomainAvailabilityService
+	params  Params
+	events  chan Event
+
+	mu      sync.Mutex
+	ctx     context.Context
+	wg      sync.WaitGroup
+	targets map[string]*target
+	states  map[string]domainavailability.Availability
+
+	// running holds the channel closed when the last started check loop of the domain name exits
+	running map[string]chan struct{}
+}
+
+// New creates Watcher backed by the specified service.
+func New(service domainavailability.DomainAvailabilityService, params Params) *Watcher {
+	if params.Interval <= 0 {
+		params.Interval = defaultInterval
+	}
+
+	if params.EventBuffer <= 0 {
+		params.EventBuffer = defaultEventBuffer
+	}
+
+	return &Watcher{
+		service: service,
+		params:  params,
+		events:  make(chan Event, params.EventBuffer),
+		targets: make(map[string]*target),
+		states:  make(map[string]domainavailability.Availability),
+		running: make(map[string]chan struct{}),
+	}
+}
+
+// Events returns the channel of change events. Events are dropped when the channel is full,
+// notifiers receive every event regardless. The channel is closed when Run returns.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Add adds the domain name to the watchlist. Zero interval means the Params interval.
+// Adding an already watched domain name replaces its interval and options: the old check loop is stopped
+// and the new one starts checking once the old one has exited.
+func (w *Watcher) Add(domainName string, interval time.Duration, opts ...domainavailability.Option) {
+	if interval <= 0 {
+		interval = w.params.Interval
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if old, ok := w.targets[domainName]; ok && old.cancel != nil {
+		old.cancel()
+	}
+
+	t := &target{
+		domainName: domainName,
+		interval:   interval,
+		opts:       opts,
+	}
+	w.targets[domainName] = t
+
+	if w.ctx != nil {
+		w.start(t)
+	}
+}
+
+// Remove removes the domain name from the watchlist and forgets its state.
+func (w *Watcher) Remove(domainName string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if t, ok := w.targets[domainName]; ok && t.cancel != nil {
+		t.cancel()
+	}
+
+	delete(w.targets, domainName)
+	delete(w.states, domainName)
+}
+
+// State returns the last known registration state of the domain name,
+// Unknown if the domain name has not been checked yet.
+func (w *Watcher) State(domainName string) domainavailability.Availability {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.states[domainName]
+}
+
+// Run checks the watchlist until the context is canceled.
+// Each domain name is checked immediately and then once per its interval.
+// Run must be called only once.
+func (w *Watcher) Run(ctx context.Context) error {
+	w.mu.Lock()
+	w.ctx = ctx
+	for _, t := range w.targets {
+		w.start(t)
+	}
+	w.mu.Unlock()
+
+	<-ctx.Done()
+
+	w.mu.Lock()
+	w.ctx = nil
+	w.mu.Unlock()
+
+	w.wg.Wait()
+	close(w.events)
+
+	return ctx.Err()
+}
+
+// start runs the check loop of the target. It must be called with w.mu held.
+// The loop waits for the previous loop of the same domain name to exit, so they never overlap.
+func (w *Watcher) start(t *target) {
+	ctx, cancel := context.WithCancel(w.ctx)
+	t.cancel = cancel
+
+	previous := w.running[t.domainName]
+	done := make(chan struct{})
+	w.running[t.domainName] = done
+
+	w.wg.Add(1)
+
+	go func() {
+		defer w.wg.Done()
+		defer w.exited(t.domainName, done)
+
+		// The previous loop has been canceled, so it exits after its current check.
+		if previous != nil {
+			<-previous
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+
+		for {
+			w.check(ctx, t)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// exited marks the check loop of the domain name as exited.
+func (w *Watcher) exited(domainName string, done chan struct{}) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	close(done)
+
+	if w.running[domainName] == done {
+		delete(w.running, domainName)
+	}
+}
+
+// check checks the target once and emits an event if its state has changed.
+func (w *Watcher) check(ctx context.Context, t *target) {
+	resp, _, err := w.service.Get(ctx, t.domainName, t.opts...)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.onError(t.domainName, err)
+		}
+
+		return
+	}
+
+	current := resp.Availability
+	if current.IsUnknown() {
+		return
+	}
+
+	w.mu.Lock()
+	if w.targets[t.domainName] != t {
+		w.mu.Unlock()
+
+		return
+	}
+
+	previous := w.states[t.domainName]
+	w.states[t.domainName] = current
+	w.mu.Unlock()
+
+	if previous.IsUnknown() || previous.Equal(current) {
+		return
+	}
+
+	event := Event{
+		DomainName: t.domainName,
+		Previous:   previous,
+		Current:    current,
+		CheckedAt:  time.Now(),
+	}
+
+	select {
+	case w.events <- event:
+	default:
+	}
+
+	for _, n := range w.params.Notifiers {
+		if err := n.Notify(ctx, event); err != nil {
+			w.onError(t.domainName, err)
+		}
+	}
+}
+
+// onError passes the error to the OnError callback if it's set.
+func (w *Watcher) onError(domainName string, err error) {
+	if w.params.OnError != nil {
+		w.params.OnError(domainName, err)
+	}
+}
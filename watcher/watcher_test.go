@@ -0,0 +1,236 @@
+package watcher
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// sequenceService is the DomainAvailabilityService returning the predefined sequence of states.
+type sequenceService struct {
+	mu     sync.Mutex
+	states []domainavailability.Availability
+}
+
+// Get returns the next state of the sequence, the last one is repeated.
+func (s *sequenceService) Get(
+	_ context.Context,
+	domainName string,
+	_ ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	availability := s.states[0]
+	if len(s.states) > 1 {
+		s.states = s.states[1:]
+	}
+
+	return &domainavailability.DomainAvailabilityResponse{
+		DomainName:   domainName,
+		Availability: availability,
+	}, nil, nil
+}
+
+// GetRaw is not used by Watcher.
+func (s *sequenceService) GetRaw(
+	context.Context,
+	string,
+	...domainavailability.Option,
+) (*domainavailability.Response, error) {
+	return nil, nil
+}
+
+// TestWatcher tests that state changes are emitted as events and passed to notifiers.
+func TestWatcher(t *testing.T) {
+	service := &sequenceService{states: []domainavailability.Availability{
+		domainavailability.Unavailable,
+		domainavailability.Unknown,
+		domainavailability.Unavailable,
+		domainavailability.Available,
+		domainavailability.Available,
+		domainavailability.Unavailable,
+	}}
+
+	var (
+		mu       sync.Mutex
+		received []Event
+	)
+
+	notifier := NotifierFunc(func(_ context.Context, event Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		received = append(received, event)
+
+		return nil
+	})
+
+	w := New(service, Params{Notifiers: []Notifier{notifier}})
+	w.Add("whoisxmlapi.com", time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error)
+	go func() {
+		done <- w.Run(ctx)
+	}()
+
+	var events []Event
+	for len(events) < 2 {
+		select {
+		case event := <-w.Events():
+			events = append(events, event)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Watcher.Events() timeout")
+		}
+	}
+
+	cancel()
+	<-done
+
+	if !events[0].Current.IsAvailable() || !events[0].Previous.IsUnavailable() {
+		t.Errorf("first event = %+v, want UNAVAILABLE -> AVAILABLE", events[0])
+	}
+
+	if !events[1].Current.IsUnavailable() || !events[1].Previous.IsAvailable() {
+		t.Errorf("second event = %+v, want AVAILABLE -> UNAVAILABLE", events[1])
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(received) != 2 {
+		t.Errorf("notifier received %d events, want 2", len(received))
+	}
+
+	if state := w.State("whoisxmlapi.com"); !state.IsUnavailable() {
+		t.Errorf("Watcher.State() = %v, want %v", state, domainavailability.Unavailable)
+	}
+}
+
+// slowService is the DomainAvailabilityService whose calls with the "slow" mode option block until canceled
+// and then take a while to return. It records whether calls overlapped.
+type slowService struct {
+	mu         sync.Mutex
+	active     int
+	overlapped bool
+	fastCalls  int
+}
+
+// Get returns UNAVAILABLE after the slow call is canceled, or at once for other calls.
+func (s *slowService) Get(
+	ctx context.Context,
+	domainName string,
+	opts ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	s.mu.Lock()
+	s.active++
+	s.overlapped = s.overlapped || s.active > 1
+	s.mu.Unlock()
+
+	q := make(map[string][]string)
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	if mode := q["mode"]; len(mode) > 0 && mode[0] == "SLOW" {
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	s.mu.Lock()
+	s.active--
+	if len(q["mode"]) == 0 {
+		s.fastCalls++
+	}
+	s.mu.Unlock()
+
+	return &domainavailability.DomainAvailabilityResponse{
+		DomainName:   domainName,
+		Availability: domainavailability.Unavailable,
+	}, nil, nil
+}
+
+// GetRaw is not used by Watcher.
+func (s *slowService) GetRaw(
+	context.Context,
+	string,
+	...domainavailability.Option,
+) (*domainavailability.Response, error) {
+	return nil, nil
+}
+
+// TestWatcherReplace tests the check loop of a replaced domain name doesn't overlap with the old one.
+func TestWatcherReplace(t *testing.T) {
+	service := &slowService{}
+
+	w := New(service, Params{})
+	w.Add("whoisxmlapi.com", time.Hour, domainavailability.OptionMode("slow"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error)
+
+	go func() {
+		done <- w.Run(ctx)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	w.Add("whoisxmlapi.com", time.Millisecond)
+
+	time.Sleep(20 * time.Millisecond)
+	w.Remove("whoisxmlapi.com")
+	w.Add("whoisxmlapi.com", time.Millisecond, domainavailability.OptionMode("slow"))
+	w.Add("whoisxmlapi.com", time.Millisecond)
+
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	<-done
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if service.overlapped {
+		t.Errorf("checks of the replaced domain name overlapped")
+	}
+
+	if service.fastCalls == 0 {
+		t.Errorf("the new check loop didn't run")
+	}
+}
+
+// TestWebhookNotifier tests that the event is posted as JSON.
+func TestWebhookNotifier(t *testing.T) {
+	var got Event
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		if err := json.NewDecoder(req.Body).Decode(&got); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	n := &WebhookNotifier{URL: server.URL, HTTPClient: server.Client()}
+
+	event := Event{DomainName: "whoisxmlapi.com", Previous: domainavailability.Unavailable, Current: domainavailability.Available}
+	if err := n.Notify(context.Background(), event); err != nil {
+		t.Fatalf("WebhookNotifier.Notify() error = %v", err)
+	}
+
+	if got.DomainName != event.DomainName || got.Current != event.Current || got.Previous != event.Previous {
+		t.Errorf("WebhookNotifier.Notify() sent %+v, want %+v", got, event)
+	}
+}
@@ -0,0 +1,104 @@
+package zone
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+var _ domainavailability.DomainAvailabilityService = &Checker{}
+
+// Checker answers UNAVAILABLE offline for the names delegated in the indexed zones
+// and defers the rest to the wrapped service. It's safe for concurrent use.
+type Checker struct {
+	service domainavailability.DomainAvailabilityService
+	indexes map[string]*Index
+
+	offline uint64
+	online  uint64
+}
+
+// NewChecker creates Checker using the zone indexes. Later indexes replace earlier ones of the same TLD.
+func NewChecker(service domainavailability.DomainAvailabilityService, indexes ...*Index) *Checker {
+	c := &Checker{
+		service: service,
+		indexes: make(map[string]*Index, len(indexes)),
+	}
+
+	for _, ix := range indexes {
+		c.indexes[ix.TLD()] = ix
+	}
+
+	return c
+}
+
+// Delegated reports whether the domain name is delegated in an indexed zone.
+// Only second-level names of the indexed TLDs can be found.
+func (c *Checker) Delegated(domainName string) (bool, error) {
+	name := normalizeName(domainName)
+
+	i := strings.IndexByte(name, '.')
+	if i <= 0 {
+		return false, nil
+	}
+
+	ix, ok := c.indexes[name[i+1:]]
+	if !ok {
+		return false, nil
+	}
+
+	return ix.Contains(name[:i])
+}
+
+// Get returns UNAVAILABLE for the names delegated in the indexed zones without calling the API.
+// In this case Response is nil, Meta.Source is SourceZone and Meta.Mode and Meta.Credits are the effective
+// options like for API requests. Other names are checked via the service.
+func (c *Checker) Get(
+	ctx context.Context,
+	domainName string,
+	opts ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	delegated, err := c.Delegated(domainName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !delegated {
+		atomic.AddUint64(&c.online, 1)
+		return c.service.Get(ctx, domainName, opts...)
+	}
+
+	atomic.AddUint64(&c.offline, 1)
+
+	isAvailable := domainavailability.StringBool(false)
+	mode, credits := domainavailability.ModeAndCredits(opts...)
+
+	return &domainavailability.DomainAvailabilityResponse{
+		DomainName:   domainName,
+		Availability: domainavailability.Unavailable,
+		IsAvailable:  &isAvailable,
+		Meta: &domainavailability.ResponseMeta{
+			CheckedAt: time.Now().UTC(),
+			Mode:      mode,
+			Credits:   credits,
+			Source:    domainavailability.SourceZone,
+		},
+	}, nil, nil
+}
+
+// GetRaw calls the service as the raw API response can't be inferred offline.
+func (c *Checker) GetRaw(
+	ctx context.Context,
+	domainName string,
+	opts ...domainavailability.Option,
+) (*domainavailability.Response, error) {
+	return c.service.GetRaw(ctx, domainName, opts...)
+}
+
+// Stats returns the number of Get calls answered offline and deferred to the service.
+func (c *Checker) Stats() (offline, online uint64) {
+	return atomic.LoadUint64(&c.offline), atomic.LoadUint64(&c.online)
+}
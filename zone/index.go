@@ -0,0 +1,288 @@
+package zone
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// The index file layout:
+//
+//	header:  indexMagic, the TLD and '\n'
+//	body:    sorted unique labels, each followed by '\n', grouped in blocks of blockSize labels
+//	offsets: the offset of every block, uint64 little endian
+//	trailer: label count, block count, offsets position, uint64 little endian each, and trailerMagic
+const (
+	indexMagic   = "DAZONE1\n"
+	trailerMagic = "DAZEND1\n"
+	trailerSize  = 3*8 + len(trailerMagic)
+	blockSize    = 128
+
+	// maxLabelSize is the maximum length of a DNS label.
+	maxLabelSize = 63
+)
+
+// ErrInvalidIndex is returned when the file is not a zone index.
+var ErrInvalidIndex = errors.New("zone: invalid index file")
+
+// BuildIndex reads the zone file of the TLD and writes the index of the delegated names to w.
+// All labels are kept in memory while the index is built. It returns the number of indexed labels.
+func BuildIndex(w io.Writer, r io.Reader, tld string) (int, error) {
+	var labels []string
+
+	err := ParseZone(r, tld, func(label string) error {
+		labels = append(labels, label)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return WriteIndex(w, tld, labels)
+}
+
+// WriteIndex writes the index of the TLD labels to w. Labels are sorted and deduplicated in place.
+// It returns the number of indexed labels.
+func WriteIndex(w io.Writer, tld string, labels []string) (int, error) {
+	tld = normalizeName(tld)
+	if tld == "" || strings.ContainsAny(tld, "\n") {
+		return 0, fmt.Errorf("zone: invalid TLD %q", tld)
+	}
+
+	sort.Strings(labels)
+
+	unique := labels[:0]
+
+	for i, label := range labels {
+		if label == "" || len(label) > maxLabelSize || strings.ContainsAny(label, ".\n") {
+			return 0, fmt.Errorf("zone: invalid label %q", label)
+		}
+
+		if i == 0 || label != labels[i-1] {
+			unique = append(unique, label)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	pos := int64(0)
+
+	write := func(s string) {
+		n, _ := bw.WriteString(s)
+		pos += int64(n)
+	}
+
+	write(indexMagic)
+	write(tld + "\n")
+
+	offsets := make([]int64, 0, (len(unique)+blockSize-1)/blockSize)
+
+	for i, label := range unique {
+		if i%blockSize == 0 {
+			offsets = append(offsets, pos)
+		}
+
+		write(label + "\n")
+	}
+
+	offsetsPos := pos
+	buf := make([]byte, 8)
+
+	for _, off := range offsets {
+		binary.LittleEndian.PutUint64(buf, uint64(off))
+		_, _ = bw.Write(buf)
+	}
+
+	for _, v := range []int64{int64(len(unique)), int64(len(offsets)), offsetsPos} {
+		binary.LittleEndian.PutUint64(buf, uint64(v))
+		_, _ = bw.Write(buf)
+	}
+
+	write(trailerMagic)
+
+	return len(unique), bw.Flush()
+}
+
+// Index is the read-only index of the delegated names of a TLD.
+// Only the first label of every block is kept in memory, blocks are read from the file on lookups.
+// It's safe for concurrent use.
+type Index struct {
+	r      io.ReaderAt
+	closer io.Closer
+	tld    string
+	count  int
+
+	// firsts are the first labels of the blocks.
+	firsts []string
+
+	// offsets are the block offsets followed by the end of the body.
+	offsets []int64
+}
+
+// OpenIndex opens the index file written by WriteIndex.
+func OpenIndex(path string) (*Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	ix, err := NewIndex(f, info.Size())
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	ix.closer = f
+
+	return ix, nil
+}
+
+// NewIndex reads the index of the given size from r.
+func NewIndex(r io.ReaderAt, size int64) (*Index, error) {
+	if size < int64(len(indexMagic)+trailerSize) {
+		return nil, ErrInvalidIndex
+	}
+
+	header := make([]byte, len(indexMagic)+maxLabelSize+2)
+	n, err := r.ReadAt(header, 0)
+
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	header = header[:n]
+	if !bytes.HasPrefix(header, []byte(indexMagic)) {
+		return nil, ErrInvalidIndex
+	}
+
+	end := bytes.IndexByte(header[len(indexMagic):], '\n')
+	if end < 0 {
+		return nil, ErrInvalidIndex
+	}
+
+	trailer := make([]byte, trailerSize)
+	if _, err = r.ReadAt(trailer, size-int64(trailerSize)); err != nil {
+		return nil, err
+	}
+
+	if string(trailer[3*8:]) != trailerMagic {
+		return nil, ErrInvalidIndex
+	}
+
+	count := binary.LittleEndian.Uint64(trailer[0:])
+	blocks := binary.LittleEndian.Uint64(trailer[8:])
+	offsetsPos := int64(binary.LittleEndian.Uint64(trailer[16:]))
+
+	if offsetsPos < 0 || uint64(size-offsetsPos-int64(trailerSize)) != blocks*8 || count > blocks*blockSize {
+		return nil, ErrInvalidIndex
+	}
+
+	ix := &Index{
+		r:       r,
+		tld:     string(header[len(indexMagic) : len(indexMagic)+end]),
+		count:   int(count),
+		firsts:  make([]string, blocks),
+		offsets: make([]int64, blocks+1),
+	}
+
+	buf := make([]byte, 8*blocks)
+	if _, err = r.ReadAt(buf, offsetsPos); err != nil {
+		return nil, err
+	}
+
+	for i := range ix.firsts {
+		ix.offsets[i] = int64(binary.LittleEndian.Uint64(buf[8*i:]))
+	}
+
+	ix.offsets[blocks] = offsetsPos
+
+	first := make([]byte, maxLabelSize+1)
+
+	for i := range ix.firsts {
+		length := ix.offsets[i+1] - ix.offsets[i]
+		if length <= 0 || ix.offsets[i] < int64(len(indexMagic)+end+1) {
+			return nil, ErrInvalidIndex
+		}
+
+		if length > int64(len(first)) {
+			length = int64(len(first))
+		}
+
+		if _, err = r.ReadAt(first[:length], ix.offsets[i]); err != nil {
+			return nil, err
+		}
+
+		nl := bytes.IndexByte(first[:length], '\n')
+		if nl <= 0 {
+			return nil, ErrInvalidIndex
+		}
+
+		ix.firsts[i] = string(first[:nl])
+	}
+
+	return ix, nil
+}
+
+// TLD returns the TLD of the index.
+func (ix *Index) TLD() string {
+	return ix.tld
+}
+
+// Len returns the number of indexed labels.
+func (ix *Index) Len() int {
+	return ix.count
+}
+
+// Contains reports whether the label, e.g. "example" for "example.com", is delegated in the zone.
+func (ix *Index) Contains(label string) (bool, error) {
+	label = strings.ToLower(label)
+
+	b := sort.SearchStrings(ix.firsts, label)
+	if b < len(ix.firsts) && ix.firsts[b] == label {
+		return true, nil
+	}
+
+	if b == 0 {
+		return false, nil
+	}
+
+	block := make([]byte, ix.offsets[b]-ix.offsets[b-1])
+	if _, err := ix.r.ReadAt(block, ix.offsets[b-1]); err != nil {
+		return false, err
+	}
+
+	for len(block) > 0 {
+		nl := bytes.IndexByte(block, '\n')
+		if nl < 0 {
+			return false, ErrInvalidIndex
+		}
+
+		if string(block[:nl]) == label {
+			return true, nil
+		}
+
+		block = block[nl+1:]
+	}
+
+	return false, nil
+}
+
+// Close closes the index file opened by OpenIndex.
+func (ix *Index) Close() error {
+	if ix.closer == nil {
+		return nil
+	}
+
+	return ix.closer.Close()
+}
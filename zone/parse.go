@@ -0,0 +1,138 @@
+// Package zone infers availability offline from registry zone file snapshots.
+//
+// ParseZone reads a zone file in the master file format (RFC 1035 section 5) and reports the delegated names,
+// BuildIndex writes them to a compact sorted index file and Checker answers UNAVAILABLE for the indexed names
+// without calling the API.
+package zone
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxLineSize is the maximum length of a zone file line.
+const maxLineSize = 64 * 1024
+
+// classes are the record classes which may precede the record type.
+var classes = map[string]bool{"in": true, "cs": true, "ch": true, "hs": true}
+
+// ParseZone reads the zone file of the TLD origin, e.g. "com", and calls fn for every NS record
+// of a second-level name with the name's label, e.g. "example" for "example.com.".
+// The same label is reported once per NS record, so fn must tolerate duplicates.
+func ParseZone(r io.Reader, origin string, fn func(label string) error) error {
+	origin = normalizeName(origin)
+	if origin == "" {
+		return fmt.Errorf("zone: empty origin")
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxLineSize)
+
+	var (
+		lineNo    int
+		parens    int
+		lastOwner string
+		curOrigin = origin
+	)
+
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+
+		if i := strings.IndexByte(line, ';'); i >= 0 {
+			line = line[:i]
+		}
+
+		continuation := parens > 0
+		parens += strings.Count(line, "(") - strings.Count(line, ")")
+
+		if continuation || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Fields(strings.ToLower(line))
+
+		if strings.HasPrefix(fields[0], "$") {
+			if fields[0] == "$origin" && len(fields) > 1 {
+				curOrigin = normalizeName(fields[1])
+			}
+
+			continue
+		}
+
+		owner := lastOwner
+		if line[0] != ' ' && line[0] != '\t' {
+			owner = absoluteName(fields[0], curOrigin)
+			fields = fields[1:]
+		}
+
+		lastOwner = owner
+
+		rrType, ok := recordType(fields)
+		if !ok {
+			return fmt.Errorf("zone: line %d: no record type", lineNo)
+		}
+
+		if rrType != "ns" {
+			continue
+		}
+
+		label := strings.TrimSuffix(owner, "."+origin)
+		if label == owner || label == "" || strings.Contains(label, ".") {
+			continue
+		}
+
+		if err := fn(label); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// recordType returns the record type skipping the optional TTL and class fields.
+func recordType(fields []string) (string, bool) {
+	for i := 0; i < len(fields) && i < 3; i++ {
+		if classes[fields[i]] || isTTL(fields[i]) {
+			continue
+		}
+
+		return fields[i], true
+	}
+
+	return "", false
+}
+
+// isTTL reports whether the field is a TTL, e.g. 172800 or 2d.
+func isTTL(f string) bool {
+	if f == "" || f[0] < '0' || f[0] > '9' {
+		return false
+	}
+
+	for i := 0; i < len(f); i++ {
+		if c := f[i]; !(c >= '0' && c <= '9' || strings.IndexByte("smhdw", c) >= 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// absoluteName returns the owner name without the trailing dot, relative names are appended to the origin.
+func absoluteName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return normalizeName(name)
+	default:
+		return normalizeName(name + "." + origin)
+	}
+}
+
+// normalizeName returns the name in lower case without surrounding spaces and dots.
+func normalizeName(name string) string {
+	return strings.Trim(strings.ToLower(strings.TrimSpace(name)), ".")
+}
@@ -0,0 +1,212 @@
+package zone
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	domainavailability "github.com/whois-api-llc/domain-availability-go"
+)
+
+// testZone is a fragment of a TLD zone file.
+const testZone = `$ORIGIN COM.
+$TTL 900
+@ IN SOA a.gtld-servers.net. nstld.verisign-grs.com. (
+		1700000000 ; serial
+		1800 900 604800 86400 )
+@ 172800 IN NS a.gtld-servers.net.
+EXAMPLE NS NS1.EXAMPLE
+ NS NS2.EXAMPLE
+NS1.EXAMPLE A 192.0.2.1
+whoisxmlapi.com. 172800 IN NS ns1.whoisxmlapi.com.
+Zeta IN 2d NS ns.zeta.net. ; comment
+acme NS ns.acme.net.
+sub.acme NS ns.acme.net.
+$ORIGIN acme.com.
+www NS ns.acme.net.
+`
+
+// TestParseZone tests the delegated labels found in the zone file.
+func TestParseZone(t *testing.T) {
+	var labels []string
+
+	err := ParseZone(strings.NewReader(testZone), "com.", func(label string) error {
+		labels = append(labels, label)
+		return nil
+	})
+	checkErr(t, err, "")
+
+	want := "example,example,whoisxmlapi,zeta,acme"
+	if got := strings.Join(labels, ","); got != want {
+		t.Errorf("ParseZone() = %s, want %s", got, want)
+	}
+
+	err = ParseZone(strings.NewReader("example 900 IN\n"), "com", func(string) error { return nil })
+	checkErr(t, err, "zone: line 1: no record type")
+}
+
+// TestIndex tests writing and reading the index.
+func TestIndex(t *testing.T) {
+	labels := make([]string, 0, 3*blockSize)
+	for i := 0; i < 3*blockSize; i++ {
+		labels = append(labels, fmt.Sprintf("name%04d", 2*i))
+	}
+
+	labels = append(labels, "name0000")
+
+	path := filepath.Join(t.TempDir(), "com.idx")
+
+	f, err := os.Create(path)
+	checkErr(t, err, "")
+
+	n, err := WriteIndex(f, "COM", labels)
+	checkErr(t, err, "")
+	checkErr(t, f.Close(), "")
+
+	if n != 3*blockSize {
+		t.Errorf("WriteIndex() = %d, want %d", n, 3*blockSize)
+	}
+
+	ix, err := OpenIndex(path)
+	checkErr(t, err, "")
+
+	defer ix.Close()
+
+	if ix.TLD() != "com" || ix.Len() != 3*blockSize {
+		t.Errorf("OpenIndex() = %s %d", ix.TLD(), ix.Len())
+	}
+
+	for i := 0; i < 3*blockSize*2; i++ {
+		got, err := ix.Contains(fmt.Sprintf("name%04d", i))
+		checkErr(t, err, "")
+
+		if want := i%2 == 0; got != want {
+			t.Errorf("Contains(name%04d) = %v, want %v", i, got, want)
+		}
+	}
+
+	for _, label := range []string{"", "a", "zzz"} {
+		if got, err := ix.Contains(label); got || err != nil {
+			t.Errorf("Contains(%q) = %v, %v, want false", label, got, err)
+		}
+	}
+
+	if _, err = NewIndex(bytes.NewReader([]byte("not an index at all, just text")), 30); !errors.Is(err, ErrInvalidIndex) {
+		t.Errorf("NewIndex() error = %v, want %v", err, ErrInvalidIndex)
+	}
+}
+
+// countingService is the DomainAvailabilityService counting calls.
+type countingService struct {
+	calls int
+}
+
+// Get returns AVAILABLE.
+func (s *countingService) Get(
+	_ context.Context,
+	domainName string,
+	_ ...domainavailability.Option,
+) (*domainavailability.DomainAvailabilityResponse, *domainavailability.Response, error) {
+	s.calls++
+
+	return &domainavailability.DomainAvailabilityResponse{
+		DomainName:   domainName,
+		Availability: domainavailability.Available,
+	}, nil, nil
+}
+
+// GetRaw is not used by the test.
+func (s *countingService) GetRaw(context.Context, string, ...domainavailability.Option) (*domainavailability.Response, error) {
+	return nil, nil
+}
+
+// TestChecker tests offline answers and deferring to the service.
+func TestChecker(t *testing.T) {
+	var buf bytes.Buffer
+
+	_, err := BuildIndex(&buf, strings.NewReader(testZone), "com")
+	checkErr(t, err, "")
+
+	ix, err := NewIndex(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	checkErr(t, err, "")
+
+	service := &countingService{}
+	checker := NewChecker(service, ix)
+
+	tests := []struct {
+		domain string
+		want   domainavailability.Availability
+		source domainavailability.Source
+	}{
+		{"Example.COM.", domainavailability.Unavailable, domainavailability.SourceZone},
+		{"acme.com", domainavailability.Unavailable, domainavailability.SourceZone},
+		{"free.com", domainavailability.Available, ""},
+		{"example.net", domainavailability.Available, ""},
+		{"www.acme.com", domainavailability.Available, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			got, _, err := checker.Get(context.Background(), tt.domain)
+			checkErr(t, err, "")
+
+			if got.Availability != tt.want {
+				t.Errorf("Get() = %s, want %s", got.Availability, tt.want)
+			}
+
+			var source domainavailability.Source
+			if got.Meta != nil {
+				source = got.Meta.Source
+			}
+
+			if source != tt.source {
+				t.Errorf("Get() source = %s, want %s", source, tt.source)
+			}
+		})
+	}
+
+	if offline, online := checker.Stats(); offline != 2 || online != 3 || service.calls != 3 {
+		t.Errorf("Stats() = %d, %d, calls %d, want 2, 3, 3", offline, online, service.calls)
+	}
+
+	metaTests := []struct {
+		name    string
+		opts    []domainavailability.Option
+		mode    string
+		credits string
+	}{
+		{"defaults", nil, domainavailability.DefaultMode, domainavailability.DefaultCredits},
+		{
+			"options",
+			[]domainavailability.Option{
+				domainavailability.OptionMode(domainavailability.ModeDNSAndWHOIS),
+				domainavailability.OptionCredits("DA"),
+			},
+			domainavailability.ModeDNSAndWHOIS,
+			"DA",
+		},
+	}
+	for _, tt := range metaTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := checker.Get(context.Background(), "acme.com", tt.opts...)
+			checkErr(t, err, "")
+
+			if got.Meta.Mode != tt.mode || got.Meta.Credits != tt.credits {
+				t.Errorf("Get() meta = %s, %s, want %s, %s", got.Meta.Mode, got.Meta.Credits, tt.mode, tt.credits)
+			}
+		})
+	}
+}
+
+// checkErr checks for an error.
+func checkErr(t *testing.T, err error, want string) {
+	t.Helper()
+
+	if (err != nil || want != "") && (err == nil || err.Error() != want) {
+		t.Errorf("error = %v, wantErr %v", err, want)
+	}
+}